@@ -1,3 +1,10 @@
 data "yba_backup_info" "backup" {
   universe_uuid = "universe-having-backups-uuid"
 }
+
+data "yba_backup_info" "completed_ysql_backups" {
+  universe_uuid = "universe-having-backups-uuid"
+  backup_type   = "PGSQL_TABLE_TYPE"
+  states        = ["Completed"]
+  keyspaces     = ["yugabyte"]
+}
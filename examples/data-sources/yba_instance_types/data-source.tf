@@ -0,0 +1,5 @@
+data "yba_instance_types" "instance_types" {
+  provider_uuid = "<provider-uuid>"
+  region        = "us-west-2"
+  arch          = "x86_64"
+}
@@ -0,0 +1,10 @@
+data "yba_node_agents" "node_agents" {
+  provider_uuid = "<onprem-provider-uuid>"
+}
+
+output "outdated_node_agents" {
+  value = [
+    for agent in data.yba_node_agents.node_agents.node_agents : agent.ip
+    if !agent.version_matched
+  ]
+}
@@ -1,3 +1,26 @@
 data "yba_onprem_preflight" "preflight_check" {
   provider_id = "example-onprem-provider-uuid"
 }
+
+data "yba_onprem_preflight" "preflight_report" {
+  provider_id   = "example-onprem-provider-uuid"
+  nodes         = ["<node-1-ip>", "<node-2-ip>"]
+  parallelism   = 10
+  fail_on_error = false
+}
+
+output "failed_nodes" {
+  value = [
+    for result in data.yba_onprem_preflight.preflight_report.results :
+    "${result.ip}: ${result.error}" if !result.passed
+  ]
+}
+
+output "failed_checks" {
+  value = flatten([
+    for result in data.yba_onprem_preflight.preflight_report.results : [
+      for check in result.checks :
+      "${result.ip}: ${check.name} ${check.message}" if !check.passed
+    ]
+  ])
+}
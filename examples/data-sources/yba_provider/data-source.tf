@@ -0,0 +1,11 @@
+data "yba_provider" "provider" {
+  name = "<provider-name>"
+}
+
+output "zone_subnets" {
+  value = flatten([
+    for region in data.yba_provider.provider.regions : [
+      for zone in region.zones : zone.subnet
+    ]
+  ])
+}
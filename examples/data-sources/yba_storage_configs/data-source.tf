@@ -6,3 +6,14 @@ data "yba_storage_configs" "configs_gcs" {
   // To fetch id of a particular storage config
   config_name = "<storage-config-name>"
 }
+
+output "storage_config_locations" {
+  // Backup locations of each storage config, with their per-region locations
+  value = {
+    for config in data.yba_storage_configs.configs.storage_configs :
+    config.config_name => {
+      backup_location = config.backup_location
+      region_location = config.region_location
+    }
+  }
+}
@@ -0,0 +1,25 @@
+resource "yba_backup" "universe_backup" {
+  universe_uuid       = "<universe-uuid>"
+  storage_config_uuid = "<storage-config-uuid>"
+  backup_type         = "<table-type>"
+  keyspace_table {
+    keyspace = "<keyspace-name>"
+  }
+}
+
+resource "yba_backup" "universe_backup_detailed" {
+  universe_uuid       = "<universe-uuid>"
+  storage_config_uuid = "<storage-config-uuid>"
+  backup_type         = "<table-type>"
+  keyspace_table {
+    keyspace        = "<keyspace-name>"
+    table_name_list = ["<table-name>"]
+  }
+  keyspace_table {
+    keyspace = "<keyspace-name>"
+  }
+  time_before_delete = "720h"
+  sse                = false
+  parallelism        = 8
+  delete_backup      = true
+}
@@ -0,0 +1,8 @@
+resource "yba_backup_retention" "universe_retention" {
+  universe_uuid = "<universe-uuid>"
+  keep_last     = 7
+  max_age       = "720h"
+  keyspaces     = ["<keyspace-name>"]
+
+  confirm_deletion = true
+}
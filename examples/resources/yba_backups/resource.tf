@@ -8,8 +8,14 @@ resource "yba_backups" "universe_backup_schedule" {
 }
 
 resource "yba_backups" "universe_backup_schedule_detailed" {
-  universe_uuid        = "<universe-uuid>"
-  keyspace             = "<keyspace name>"
+  universe_uuid = "<universe-uuid>"
+  keyspace_table {
+    keyspace        = "<keyspace name>"
+    table_name_list = ["<table-name>"]
+  }
+  keyspace_table {
+    keyspace = "<keyspace name>"
+  }
   storage_config_uuid  = "<storage-config-uuid>"
   time_before_delete   = "24h"
   sse                  = false
@@ -17,6 +23,7 @@ resource "yba_backups" "universe_backup_schedule_detailed" {
   frequency            = "120m"
   parallelism          = 8
   delete_backup        = true
+  status               = "Active"
   schedule_name        = "<schedule-name>"
   backup_type          = "<table-type>"
 }
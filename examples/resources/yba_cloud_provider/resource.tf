@@ -204,3 +204,33 @@ resource "yba_cloud_provider" "aws_cloud_provider_image_bundles" {
   }
   air_gap_install = false
 }
+
+resource "yba_cloud_provider" "kubernetes" {
+  code = "kubernetes"
+  name = "kubernetes-provider"
+  kubernetes_config_settings {
+    kubernetes_provider = "gke"
+    image_registry      = "quay.io/yugabyte/yugabyte"
+    pull_secret_content = file("<pull-secret-file-path>")
+    pull_secret_name    = "yugabyte-k8s-pull-secret.yaml"
+    storage_class       = "standard-rwo"
+  }
+  regions {
+    code = "us-west1"
+    name = "us-west1"
+    zones {
+      code = "us-west1-a"
+      name = "us-west1-a"
+      kubernetes_settings {
+        kube_config_content = file("<kubeconfig-file-path>")
+        kube_namespace      = "yb-us-west1-a"
+        overrides           = <<-EOT
+          tserver:
+            resources:
+              requests:
+                cpu: 2
+        EOT
+      }
+    }
+  }
+}
@@ -0,0 +1,15 @@
+resource "yba_image_bundle" "image_bundle" {
+  provider_uuid  = yba_cloud_provider.cloud_provider.id
+  name           = "<image-bundle-name>"
+  use_as_default = true
+  details {
+    arch            = "x86_64"
+    global_yb_image = "<global-image>"
+    region_overrides = {
+      "us-west-2" = "<ami-id>"
+    }
+    ssh_user    = "ec2-user"
+    ssh_port    = 22
+    use_imds_v2 = true
+  }
+}
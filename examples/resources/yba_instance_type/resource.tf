@@ -0,0 +1,14 @@
+resource "yba_instance_type" "instance_type" {
+  provider_uuid      = "<provider-uuid>"
+  instance_type_code = "c7i.2xlarge"
+  num_cores          = 8
+  mem_size_gb        = 16
+  instance_type_details {
+    arch = "x86_64"
+    volume_details_list {
+      mount_path     = "/mnt/d0"
+      volume_size_gb = 250
+      volume_type    = "EBS"
+    }
+  }
+}
@@ -13,3 +13,16 @@ resource "yba_onprem_node_instance" "onprem" {
   region        = "<region-name>"
   zone          = "<zone-name>"
 }
+
+resource "yba_onprem_node_instance" "onprem_node_agent" {
+  provider_uuid = "<onprem-provider-uuid>"
+  instance_type = "<instance-type-name>"
+  ip            = "<node-ip-instance>"
+  region        = "<region-name>"
+  zone          = "<zone-name>"
+  ssh_user      = "<ssh-user>"
+  node_agent {
+    ssh_private_key_file_path = "<path-to-ssh-private-key>"
+    upgrade                   = true
+  }
+}
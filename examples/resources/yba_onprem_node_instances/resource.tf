@@ -0,0 +1,15 @@
+resource "yba_onprem_node_instances" "nodes" {
+  provider_uuid = "<onprem-provider-uuid>"
+  node_instances {
+    instance_type = "<instance-type-name>"
+    ip            = "<node-1-ip>"
+    region        = "<region-name>"
+    zone          = "<zone-1-name>"
+  }
+  node_instances {
+    instance_type = "<instance-type-name>"
+    ip            = "<node-2-ip>"
+    region        = "<region-name>"
+    zone          = "<zone-2-name>"
+  }
+}
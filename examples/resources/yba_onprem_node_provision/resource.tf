@@ -0,0 +1,15 @@
+resource "yba_onprem_node_provision" "node" {
+  provider_uuid             = "<onprem-provider-uuid>"
+  ip                        = "<node-ip>"
+  ssh_user                  = "<ssh-user>"
+  ssh_private_key_file_path = "<path-to-ssh-private-key>"
+
+  # provision_instance.py copied from the YugabyteDB Anywhere host
+  provision_script_file_path = "<path-to-provision_instance.py>"
+  provision_command          = "sudo python3 /tmp/yba-provision-node --ip <node-ip> --mount_points /data"
+  install_node_agent         = true
+
+  instance_type = "<instance-type-name>"
+  region        = "<region-name>"
+  zone          = "<zone-name>"
+}
@@ -0,0 +1,7 @@
+resource "yba_pitr_config" "pitr" {
+  universe_uuid     = "<universe-uuid>"
+  keyspace_name     = "<keyspace-name>"
+  table_type        = "<table-type>"
+  retention_period  = "168h"
+  schedule_interval = "24h"
+}
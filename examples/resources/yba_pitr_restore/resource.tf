@@ -0,0 +1,5 @@
+resource "yba_pitr_restore" "pitr_restore" {
+  universe_uuid    = "<universe-uuid>"
+  pitr_config_uuid = yba_pitr_config.pitr.id
+  restore_time     = "<timestamp-in-RFC3339-format>"
+}
@@ -0,0 +1,7 @@
+resource "yba_provider_access_key" "rotated_key" {
+  provider_uuid           = yba_cloud_provider.cloud_provider.id
+  key_pair_name           = "<new-key-pair-name>"
+  ssh_private_key_content = file("<new-private-key-file-path>")
+  universe_uuids          = [yba_universe.universe.id]
+  delete_old_key          = true
+}
@@ -6,3 +6,15 @@ resource "yba_restore" "restore" {
   restore_type        = "<table-type>"
   storage_config_uuid = "<storage-config-uuid-of-backup>"
 }
+
+resource "yba_restore" "restore_from_backup" {
+  universe_uuid = "<target-universe-uuid>"
+  backup_uuid   = data.yba_backup_info.completed_ysql_backups.backups[0].backup_uuid
+
+  keyspaces {
+    keyspace          = "yugabyte"
+    new_keyspace_name = "yugabyte_restored"
+  }
+
+  restore_to_point_in_time_millis = 1700000000000
+}
@@ -32,4 +32,50 @@ resource "yba_storage_config_resource" "az_storage_config" {
   azure_credentials {
     sas_token = "<azure-sas-token>"
   }
-}
\ No newline at end of file
+}
+
+resource "yba_storage_config_resource" "minio_storage_config" {
+  name              = "S3"
+  backup_location   = "s3://<bucket-name>"
+  config_name       = "<storage-config-name>"
+  endpoint          = "https://minio.example.com:9000"
+  path_style_access = true
+  ca_certificate    = file("<path-to-minio-ca-certificate>")
+  s3_credentials {
+    access_key_id     = "<minio-access-key>"
+    secret_access_key = "<minio-secret-key>"
+  }
+}
+
+resource "yba_storage_config_resource" "nfs_storage_config" {
+  name            = "NFS"
+  backup_location = "/mnt/nfs/backups"
+  config_name     = "<storage-config-name>"
+}
+
+resource "yba_storage_config_resource" "multi_region_storage_config" {
+  name            = "S3"
+  backup_location = "s3://<default-bucket-name>"
+  config_name     = "<storage-config-name>"
+  region_location {
+    region   = "us-west-2"
+    location = "s3://<us-west-2-bucket-name>"
+  }
+  region_location {
+    region        = "eu-west-1"
+    location      = "s3://<eu-west-1-bucket-name>"
+    aws_host_base = "s3.eu-west-1.amazonaws.com"
+  }
+}
+
+resource "yba_storage_config_resource" "s3_assumed_role_storage_config" {
+  name                = "S3"
+  backup_location     = "s3://<bucket-name>"
+  config_name         = "<storage-config-name>"
+  credentials_version = 1
+  aws_credential_source {
+    aws_profile = "<aws-profile>"
+    role_arn    = "arn:aws:iam::<account-id>:role/<role-name>"
+    external_id = "<external-id>"
+  }
+}
@@ -0,0 +1,75 @@
+// Licensed to YugabyteDB, Inc. under one or more contributor license
+// agreements. See the NOTICE file distributed with this work for
+// additional information regarding copyright ownership. Yugabyte
+// licenses this file to you under the Mozilla License, Version 2.0
+// (the "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+// http://mozilla.org/MPL/2.0/.
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/yugabyte/terraform-provider-yba/internal/utils"
+)
+
+// AccessKeyRotationRequest is the request body to rotate the access key of universes
+type AccessKeyRotationRequest struct {
+	NewKeyCode    string   `json:"newKeyCode"`
+	UniverseUUIDs []string `json:"universeUUIDs"`
+}
+
+// RotateAccessKey uses REST API to rotate the access key of the universes of a provider to
+// the new key, which is not part of the platform-go-client. Returns the task UUID for each
+// universe.
+func (vc *VanillaClient) RotateAccessKey(ctx context.Context, cUUID, pUUID string,
+	req AccessKeyRotationRequest, token string) (map[string]string, error) {
+	reqBytes, err := json.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+
+	r, err := vc.makeRequest(http.MethodPost,
+		fmt.Sprintf("api/v1/customers/%s/providers/%s/access_key_rotation", cUUID, pUUID),
+		bytes.NewBuffer(reqBytes), token)
+	if err != nil {
+		return nil, fmt.Errorf("Error occured during Post call for Access Key Rotation %s",
+			err.Error())
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		return nil, fmt.Errorf("Error reading Access Key Rotation response body %s",
+			err.Error())
+	}
+
+	if r.StatusCode != http.StatusOK {
+		responseBody := utils.YbaStructuredError{}
+		if err = json.Unmarshal(body, &responseBody); err != nil {
+			return nil, fmt.Errorf("%s %s",
+				"Failed unmarshalling Access Key Rotation Response body", err.Error())
+		}
+		errorMessage := utils.ErrorFromResponseBody(responseBody)
+		return nil, fmt.Errorf("Error rotating access keys: %s", errorMessage)
+	}
+
+	tasks := make(map[string]string)
+	if err = json.Unmarshal(body, &tasks); err != nil {
+		return nil, fmt.Errorf("%s %s",
+			"Failed unmarshalling Access Key Rotation Response body", err.Error())
+	}
+	return tasks, nil
+}
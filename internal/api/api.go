@@ -91,6 +91,14 @@ type VanillaClient struct {
 	EnableHTTPS bool
 }
 
+// BaseURL returns the URL of the YugabyteDB Anywhere host
+func (c VanillaClient) BaseURL() string {
+	if c.EnableHTTPS {
+		return fmt.Sprintf("https://%s", c.Host)
+	}
+	return fmt.Sprintf("http://%s", c.Host)
+}
+
 func (c VanillaClient) makeRequest(method string, url string, body io.Reader, apiKey string) (
 	*http.Response, error) {
 	var req *http.Request
@@ -0,0 +1,77 @@
+// Licensed to YugabyteDB, Inc. under one or more contributor license
+// agreements. See the NOTICE file distributed with this work for
+// additional information regarding copyright ownership. Yugabyte
+// licenses this file to you under the Mozilla License, Version 2.0
+// (the "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+// http://mozilla.org/MPL/2.0/.
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	client "github.com/yugabyte/platform-go-client"
+	"github.com/yugabyte/terraform-provider-yba/internal/utils"
+)
+
+// RestoreBackupToPointInTime uses REST API to restore a backup to a point in time, which
+// is not part of the RestoreBackupParams model of the platform-go-client
+func (vc *VanillaClient) RestoreBackupToPointInTime(ctx context.Context, cUUID string,
+	params client.RestoreBackupParams, restoreToPointInTimeMillis int64,
+	token string) (*client.YBPTask, error) {
+	paramBytes, err := json.Marshal(params)
+	if err != nil {
+		return nil, err
+	}
+	mapping := make(map[string]interface{})
+	if err = json.Unmarshal(paramBytes, &mapping); err != nil {
+		return nil, err
+	}
+	mapping["restoreToPointInTimeMillis"] = restoreToPointInTimeMillis
+
+	reqBytes, err := json.Marshal(mapping)
+	if err != nil {
+		return nil, err
+	}
+
+	r, err := vc.makeRequest(http.MethodPost, fmt.Sprintf("api/v1/customers/%s/restore",
+		cUUID), bytes.NewBuffer(reqBytes), token)
+	if err != nil {
+		return nil, fmt.Errorf("Error occured during Post call for Restore %s", err.Error())
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		return nil, fmt.Errorf("Error reading Restore response body %s", err.Error())
+	}
+
+	if r.StatusCode != http.StatusOK {
+		responseBody := utils.YbaStructuredError{}
+		if err = json.Unmarshal(body, &responseBody); err != nil {
+			return nil, fmt.Errorf("%s %s",
+				"Failed unmarshalling Restore Response body", err.Error())
+		}
+		errorMessage := utils.ErrorFromResponseBody(responseBody)
+		return nil, fmt.Errorf("Error restoring backup: %s", errorMessage)
+	}
+
+	task := client.YBPTask{}
+	if err = json.Unmarshal(body, &task); err != nil {
+		return nil, fmt.Errorf("%s %s",
+			"Failed unmarshalling Restore Response body", err.Error())
+	}
+	return &task, nil
+}
@@ -0,0 +1,139 @@
+// Licensed to YugabyteDB, Inc. under one or more contributor license
+// agreements. See the NOTICE file distributed with this work for
+// additional information regarding copyright ownership. Yugabyte
+// licenses this file to you under the Mozilla License, Version 2.0
+// (the "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+// http://mozilla.org/MPL/2.0/.
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	client "github.com/yugabyte/platform-go-client"
+	"github.com/yugabyte/terraform-provider-yba/internal/utils"
+)
+
+// ScheduleTaskParams holds the backup parameters of a schedule, which are not part of
+// the Schedule model of the platform-go-client
+type ScheduleTaskParams struct {
+	KeyspaceTableList []client.KeyspaceTable `json:"keyspaceTableList"`
+	StorageConfigUUID string                 `json:"storageConfigUUID"`
+	UniverseUUID      string                 `json:"universeUUID"`
+}
+
+// ScheduleResponse handles the return value of the get schedule endpoint
+type ScheduleResponse struct {
+	ScheduleUUID string             `json:"scheduleUUID"`
+	ScheduleName string             `json:"scheduleName"`
+	Status       string             `json:"status"`
+	TaskParams   ScheduleTaskParams `json:"taskParams"`
+}
+
+// SchedulePagedResponse handles the return value of the list schedules endpoint
+type SchedulePagedResponse struct {
+	Entities []ScheduleResponse `json:"entities"`
+	HasNext  bool               `json:"hasNext"`
+}
+
+// GetScheduleTaskParams uses REST API to fetch the backup parameters of a schedule
+func (vc *VanillaClient) GetScheduleTaskParams(ctx context.Context, cUUID, sUUID,
+	token string) (*ScheduleTaskParams, error) {
+	r, err := vc.makeRequest(http.MethodGet,
+		fmt.Sprintf("api/v1/customers/%s/schedules/%s", cUUID, sUUID), nil, token)
+	if err != nil {
+		return nil, fmt.Errorf("Error occured during Get call for Schedule %s", err.Error())
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		return nil, fmt.Errorf("Error reading Schedule response body %s", err.Error())
+	}
+
+	if r.StatusCode != http.StatusOK {
+		responseBody := utils.YbaStructuredError{}
+		if err = json.Unmarshal(body, &responseBody); err != nil {
+			return nil, fmt.Errorf("%s %s",
+				"Failed unmarshalling Schedule Response body", err.Error())
+		}
+		errorMessage := utils.ErrorFromResponseBody(responseBody)
+		return nil, fmt.Errorf("Error fetching schedule %s: %s", sUUID, errorMessage)
+	}
+
+	schedule := ScheduleResponse{}
+	if err = json.Unmarshal(body, &schedule); err != nil {
+		return nil, fmt.Errorf("%s %s",
+			"Failed unmarshalling Schedule Response body", err.Error())
+	}
+	return &schedule.TaskParams, nil
+}
+
+// ListSchedules uses REST API to fetch the active and paused schedules along with their
+// backup parameters
+func (vc *VanillaClient) ListSchedules(ctx context.Context, cUUID, token string) (
+	[]ScheduleResponse, error) {
+	schedules := make([]ScheduleResponse, 0)
+	var offset int32
+	for {
+		req := client.SchedulePagedApiQuery{
+			Filter: client.ScheduleApiFilter{
+				Status: []string{"Active", "Paused"},
+			},
+			SortBy:    "taskType",
+			Direction: "DESC",
+			Limit:     100,
+			Offset:    offset,
+		}
+		reqBytes, err := json.Marshal(req)
+		if err != nil {
+			return nil, err
+		}
+
+		r, err := vc.makeRequest(http.MethodPost,
+			fmt.Sprintf("api/v1/customers/%s/schedules/page", cUUID), bytes.NewBuffer(reqBytes),
+			token)
+		if err != nil {
+			return nil, fmt.Errorf("Error occured during Post call for Schedules %s",
+				err.Error())
+		}
+
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			return nil, fmt.Errorf("Error reading Schedules response body %s", err.Error())
+		}
+
+		if r.StatusCode != http.StatusOK {
+			responseBody := utils.YbaStructuredError{}
+			if err = json.Unmarshal(body, &responseBody); err != nil {
+				return nil, fmt.Errorf("%s %s",
+					"Failed unmarshalling Schedules Response body", err.Error())
+			}
+			errorMessage := utils.ErrorFromResponseBody(responseBody)
+			return nil, fmt.Errorf("Error listing schedules: %s", errorMessage)
+		}
+
+		page := SchedulePagedResponse{}
+		if err = json.Unmarshal(body, &page); err != nil {
+			return nil, fmt.Errorf("%s %s",
+				"Failed unmarshalling Schedules Response body", err.Error())
+		}
+		schedules = append(schedules, page.Entities...)
+		if !page.HasNext {
+			return schedules, nil
+		}
+		offset += req.Limit
+	}
+}
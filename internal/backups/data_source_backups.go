@@ -28,10 +28,14 @@ import (
 	"github.com/yugabyte/terraform-provider-yba/internal/utils"
 )
 
+// backupsPageLimit is the number of backups fetched per page
+const backupsPageLimit = 100
+
 // Lists fetches the backups within the given set of conditions
 func Lists() *schema.Resource {
 	return &schema.Resource{
-		Description: "Retrieve list of backups.",
+		Description: "Retrieve list of backups. The flat attributes describe the latest backup " +
+			"matching the filters, while backups lists all of them.",
 
 		ReadContext: dataSourceBackupsListRead,
 
@@ -70,16 +74,126 @@ func Lists() *schema.Resource {
 				Computed:    true,
 				Description: "Storage location of the backup.",
 			},
+			"states": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Description: "List of backup states to filter by, for example Completed, " +
+					"InProgress, Failed.",
+			},
+			"keyspaces": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "List of keyspaces to filter by.",
+			},
 			"backup_type": {
-				Type:        schema.TypeString,
-				Computed:    true,
-				Description: "Type of the backup fetched.",
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(
+					[]string{"YQL_TABLE_TYPE", "PGSQL_TABLE_TYPE", "REDIS_TABLE_TYPE"}, false)),
+				Description: "Type of the backup fetched. If set, only backups of this type " +
+					"are listed. Permitted values: YQL_TABLE_TYPE, PGSQL_TABLE_TYPE, " +
+					"REDIS_TABLE_TYPE.",
 			},
 			"storage_config_uuid": {
 				Type:        schema.TypeString,
 				Computed:    true,
 				Description: "UUID of the storage configuration used for backup.",
 			},
+			"backups": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Description: "List of backups matching the filters, sorted by creation time " +
+					"with the newest first.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"backup_uuid": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "UUID of the backup.",
+						},
+						"base_backup_uuid": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "UUID of the full backup this backup is based on.",
+						},
+						"backup_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Type of the backup.",
+						},
+						"state": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "State of the backup.",
+						},
+						"storage_config_uuid": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "UUID of the storage configuration used for backup.",
+						},
+						"schedule_uuid": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "UUID of the schedule that created the backup, if any.",
+						},
+						"create_time": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Creation time of the backup, in RFC3339 format.",
+						},
+						"expiry_time": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Expiry time of the backup, in RFC3339 format.",
+						},
+						"total_backup_size_in_bytes": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Size of the backup in bytes.",
+						},
+						"incremental": {
+							Type:     schema.TypeBool,
+							Computed: true,
+							Description: "Whether the backup is an incremental backup of the " +
+								"backup base_backup_uuid.",
+						},
+						"has_incremental_backups": {
+							Type:     schema.TypeBool,
+							Computed: true,
+							Description: "Whether incremental backups were taken on top of this " +
+								"backup.",
+						},
+						"keyspace_details": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "Keyspaces in the backup and their storage locations.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"keyspace": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Keyspace name.",
+									},
+									"table_name_list": {
+										Type:        schema.TypeList,
+										Computed:    true,
+										Elem:        &schema.Schema{Type: schema.TypeString},
+										Description: "Tables of the keyspace in the backup.",
+									},
+									"storage_location": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Storage location of the keyspace backup.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -109,10 +223,12 @@ func dataSourceBackupsListRead(
 				"universe_name"))),
 			UniverseUUIDList: *utils.StringSlice(utils.CreateSingletonList(d.Get(
 				"universe_uuid"))),
+			States:       *utils.StringSlice(d.Get("states").([]interface{})),
+			KeyspaceList: *utils.StringSlice(d.Get("keyspaces").([]interface{})),
 		},
 		SortBy:    "createTime",
 		Direction: "DESC",
-		Limit:     *utils.GetInt32Pointer(10),
+		Limit:     *utils.GetInt32Pointer(backupsPageLimit),
 	}
 
 	var minTime = time.Unix(-2208988800, 0) // Jan 1, 1900
@@ -149,16 +265,26 @@ func dataSourceBackupsListRead(
 		req.Filter.DateRangeEnd = &endDate
 	}
 
-	r, response, err := c.BackupsApi.ListBackupsV2(ctx, cUUID).PageBackupsRequest(req).Execute()
+	entities, err := listBackups(ctx, c, cUUID, req, utils.DataSourceEntity)
 	if err != nil {
-		errMessage := utils.ErrorFromHTTPResponse(response, err, utils.DataSourceEntity,
-			"Backup", "Read")
-		return diag.FromErr(errMessage)
+		return diag.FromErr(err)
+	}
+	// backup type is not part of the API filter
+	backupType := d.Get("backup_type").(string)
+	backups := make([]client.BackupResp, 0)
+	for _, b := range entities {
+		if backupType == "" || b.GetBackupType() == backupType {
+			backups = append(backups, b)
+		}
+	}
+
+	if err = d.Set("backups", flattenBackupList(backups)); err != nil {
+		return diag.FromErr(err)
 	}
 
-	// Get the first entity from r
-	if len(r.Entities) > 0 {
-		chosenBackup := r.Entities[0]
+	// Get the first entity from backups
+	if len(backups) > 0 {
+		chosenBackup := backups[0]
 		err = d.Set("storage_config_uuid", chosenBackup.GetCommonBackupInfo().StorageConfigUUID)
 		if err != nil {
 			return diag.FromErr(err)
@@ -185,6 +311,62 @@ func dataSourceBackupsListRead(
 	d.Set("universe_uuid", d.Get("universe_uuid"))
 	d.Set("universe_name", d.Get("universe_name"))
 
-	d.SetId("")
+	// no backup matches the filters, the empty backups list is returned
+	d.SetId(cUUID)
 	return diags
 }
+
+// listBackups pages through all the backups matching the query
+func listBackups(ctx context.Context, c *client.APIClient, cUUID string,
+	req client.BackupPagedApiQuery, entity string) ([]client.BackupResp, error) {
+	backups := make([]client.BackupResp, 0)
+	for {
+		r, response, err := c.BackupsApi.ListBackupsV2(ctx, cUUID).PageBackupsRequest(
+			req).Execute()
+		if err != nil {
+			return nil, utils.ErrorFromHTTPResponse(response, err, entity, "Backup", "Read")
+		}
+		backups = append(backups, r.Entities...)
+		if !r.GetHasNext() {
+			return backups, nil
+		}
+		req.Offset += req.Limit
+	}
+}
+
+// isIncrementalBackup checks if the backup is taken on top of another backup. The base backup
+// UUID of full backups is their own UUID.
+func isIncrementalBackup(info client.CommonBackupInfo) bool {
+	return info.GetBaseBackupUUID() != "" && info.GetBaseBackupUUID() != info.GetBackupUUID()
+}
+
+func flattenBackupList(backups []client.BackupResp) []map[string]interface{} {
+	res := make([]map[string]interface{}, 0)
+	for _, b := range backups {
+		info := b.GetCommonBackupInfo()
+		keyspaces := make([]map[string]interface{}, 0)
+		for _, k := range info.GetResponseList() {
+			keyspaces = append(keyspaces, map[string]interface{}{
+				"keyspace":         k.GetKeyspace(),
+				"table_name_list":  k.GetTablesList(),
+				"storage_location": k.GetDefaultLocation(),
+			})
+		}
+		r := map[string]interface{}{
+			"backup_uuid":                info.GetBackupUUID(),
+			"base_backup_uuid":           info.GetBaseBackupUUID(),
+			"backup_type":                b.GetBackupType(),
+			"state":                      info.GetState(),
+			"storage_config_uuid":        info.GetStorageConfigUUID(),
+			"schedule_uuid":              b.GetScheduleUUID(),
+			"create_time":                formatBackupTime(info.CreateTime),
+			"expiry_time":                formatBackupTime(b.ExpiryTime),
+			"total_backup_size_in_bytes": info.GetTotalBackupSizeInBytes(),
+			"incremental":                isIncrementalBackup(info),
+			"has_incremental_backups":    b.GetHasIncrementalBackups(),
+			"keyspace_details":           keyspaces,
+		}
+		res = append(res, r)
+	}
+	return res
+}
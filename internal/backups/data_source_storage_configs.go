@@ -20,6 +20,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	client "github.com/yugabyte/platform-go-client"
 	"github.com/yugabyte/terraform-provider-yba/internal/api"
 	"github.com/yugabyte/terraform-provider-yba/internal/utils"
 )
@@ -44,6 +45,119 @@ func StorageConfigs() *schema.Resource {
 				Description: "Accepts name of the storage configuration. The corresponding " +
 					"storage config UUID is stored in ID to be used in *yba_backups* resource.",
 			},
+			"storage_configs": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Description: "Details of the storage configurations, filtered by " +
+					"config_name if set.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"uuid": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "UUID of the storage configuration.",
+						},
+						"config_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the storage configuration.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Type of the storage configuration: S3, GCS, AZ or NFS.",
+						},
+						"backup_location": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Default backup location.",
+						},
+						"region_location": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "Per-region backup locations.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"region": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Region code.",
+									},
+									"location": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Backup location of the region.",
+									},
+									"aws_host_base": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Host base of the S3 endpoint of the region.",
+									},
+								},
+							},
+						},
+						"state": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "State of the storage configuration.",
+						},
+						"in_use": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the storage configuration is in use.",
+						},
+						"universes": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "Universes using the storage configuration.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"uuid": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "UUID of the universe.",
+									},
+									"name": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Name of the universe.",
+									},
+								},
+							},
+						},
+						"backup_schedules": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Description: "Active and paused backup schedules using the " +
+								"storage configuration.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"uuid": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "UUID of the backup schedule.",
+									},
+									"name": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Name of the backup schedule.",
+									},
+									"universe_uuid": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "UUID of the universe backed up by the schedule.",
+									},
+									"status": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Status of the backup schedule.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -64,8 +178,16 @@ func dataSourceStorageConfigsRead(
 		return diag.FromErr(errMessage)
 	}
 
+	vc := meta.(*api.APIClient).VanillaClient
+	token := meta.(*api.APIClient).APIKey
+	schedules, err := vc.ListSchedules(ctx, cUUID, token)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	var ids []string
 	var configName string
+	storageConfigs := make([]map[string]interface{}, 0)
 	for _, config := range r {
 		if config.Type == "STORAGE" {
 			ids = append(ids, *config.ConfigUUID)
@@ -75,11 +197,17 @@ func dataSourceStorageConfigsRead(
 					d.SetId(*config.ConfigUUID)
 				}
 			}
+			if configName == "" || config.ConfigName == configName {
+				storageConfigs = append(storageConfigs, flattenStorageConfig(config, schedules))
+			}
 		}
 	}
 	if err = d.Set("uuid_list", ids); err != nil {
 		return diag.FromErr(err)
 	}
+	if err = d.Set("storage_configs", storageConfigs); err != nil {
+		return diag.FromErr(err)
+	}
 	if configName == "" {
 		if len(ids) != 0 {
 			d.SetId(ids[0])
@@ -89,3 +217,41 @@ func dataSourceStorageConfigsRead(
 	}
 	return diags
 }
+
+func flattenStorageConfig(config client.CustomerConfigUI,
+	schedules []api.ScheduleResponse) map[string]interface{} {
+	backupLocation, _ := config.GetData()["BACKUP_LOCATION"].(string)
+
+	universes := make([]map[string]interface{}, 0)
+	for _, u := range config.GetUniverseDetails() {
+		universes = append(universes, map[string]interface{}{
+			"uuid": u.GetUuid(),
+			"name": u.GetName(),
+		})
+	}
+
+	backupSchedules := make([]map[string]interface{}, 0)
+	for _, schedule := range schedules {
+		if schedule.TaskParams.StorageConfigUUID != config.GetConfigUUID() {
+			continue
+		}
+		backupSchedules = append(backupSchedules, map[string]interface{}{
+			"uuid":          schedule.ScheduleUUID,
+			"name":          schedule.ScheduleName,
+			"universe_uuid": schedule.TaskParams.UniverseUUID,
+			"status":        schedule.Status,
+		})
+	}
+
+	return map[string]interface{}{
+		"uuid":             config.GetConfigUUID(),
+		"config_name":      config.ConfigName,
+		"name":             config.Name,
+		"backup_location":  backupLocation,
+		"region_location":  flattenRegionLocations(config.GetData()["REGION_LOCATIONS"]),
+		"state":            config.GetState(),
+		"in_use":           config.GetInUse(),
+		"universes":        universes,
+		"backup_schedules": backupSchedules,
+	}
+}
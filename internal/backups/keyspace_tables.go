@@ -0,0 +1,88 @@
+// Licensed to YugabyteDB, Inc. under one or more contributor license
+// agreements. See the NOTICE file distributed with this work for
+// additional information regarding copyright ownership. Yugabyte
+// licenses this file to you under the Mozilla License, Version 2.0
+// (the "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+// http://mozilla.org/MPL/2.0/.
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package backups
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	client "github.com/yugabyte/platform-go-client"
+	"github.com/yugabyte/terraform-provider-yba/internal/utils"
+)
+
+// KeyspaceTableSchema holds the keyspaces and tables selected for a backup
+func KeyspaceTableSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		ForceNew: true,
+		Description: "Keyspaces and tables to back up. All keyspaces of the backup type " +
+			"are backed up if not set.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"keyspace": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "Keyspace to back up.",
+				},
+				"table_name_list": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+					Description: "List of table names in the keyspace to back up. " +
+						"All tables of the keyspace are backed up if neither table_name_list " +
+						"nor table_uuid_list is set.",
+				},
+				"table_uuid_list": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Description: "List of table UUIDs in the keyspace to back up.",
+				},
+			},
+		},
+	}
+}
+
+func buildKeyspaceTableList(keyspaceTables []interface{}) []client.KeyspaceTable {
+	res := make([]client.KeyspaceTable, 0)
+	for _, k := range keyspaceTables {
+		keyspaceTable := k.(map[string]interface{})
+		r := client.KeyspaceTable{
+			Keyspace:      utils.GetStringPointer(keyspaceTable["keyspace"].(string)),
+			TableNameList: utils.StringSlice(keyspaceTable["table_name_list"].([]interface{})),
+			TableUUIDList: utils.StringSlice(keyspaceTable["table_uuid_list"].([]interface{})),
+		}
+		res = append(res, r)
+	}
+	return res
+}
+
+// flattenKeyspaceTableList skips entries without a keyspace, which select every keyspace of
+// the universe and are not part of the configuration
+func flattenKeyspaceTableList(keyspaceTables []client.KeyspaceTable) []map[string]interface{} {
+	res := make([]map[string]interface{}, 0)
+	for _, k := range keyspaceTables {
+		if k.GetKeyspace() == "" {
+			continue
+		}
+		r := map[string]interface{}{
+			"keyspace":        k.GetKeyspace(),
+			"table_name_list": k.GetTableNameList(),
+			"table_uuid_list": k.GetTableUUIDList(),
+		}
+		res = append(res, r)
+	}
+	return res
+}
@@ -0,0 +1,442 @@
+// Licensed to YugabyteDB, Inc. under one or more contributor license
+// agreements. See the NOTICE file distributed with this work for
+// additional information regarding copyright ownership. Yugabyte
+// licenses this file to you under the Mozilla License, Version 2.0
+// (the "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+// http://mozilla.org/MPL/2.0/.
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package backups
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	client "github.com/yugabyte/platform-go-client"
+	"github.com/yugabyte/terraform-provider-yba/internal/api"
+	"github.com/yugabyte/terraform-provider-yba/internal/utils"
+)
+
+// ResourceBackup creates and maintains resource for on-demand backups
+func ResourceBackup() *schema.Resource {
+	return &schema.Resource{
+		Description: "On-demand backup of a universe.",
+
+		CreateContext: resourceBackupCreate,
+		ReadContext:   resourceBackupRead,
+		UpdateContext: resourceBackupUpdate,
+		DeleteContext: resourceBackupDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		CustomizeDiff: resourceOnDemandBackupDiff(),
+
+		Schema: map[string]*schema.Schema{
+			"universe_uuid": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The UUID of the universe to back up.",
+			},
+			"storage_config_uuid": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				Description: "UUID of the storage configuration to use. Can be " +
+					"retrieved from the storage config data source.",
+			},
+			"backup_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(
+					[]string{"YQL_TABLE_TYPE", "REDIS_TABLE_TYPE", "PGSQL_TABLE_TYPE"}, false)),
+				Description: "Type of the backup. Permitted values: YQL_TABLE_TYPE, " +
+					"REDIS_TABLE_TYPE, PGSQL_TABLE_TYPE.",
+			},
+			"keyspace_table": KeyspaceTableSchema(),
+			"time_before_delete": {
+				Type:     schema.TypeString,
+				Optional: true, // If not provided, backup kept indefinitely
+				Description: "Time before deleting the backup from storage. Accepts " +
+					"string duration in the standard format <https://pkg.go.dev/time#Duration>. " +
+					"Backup is kept indefinitely if not set. Changing this value updates the " +
+					"expiry of the backup relative to the time of the update. Once set, the " +
+					"value cannot be removed since YugabyteDB Anywhere does not allow removing " +
+					"the expiry of a backup.",
+			},
+			"sse": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "Is SSE.",
+			},
+			"parallelism": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				Description: "Number of concurrent commands to run on nodes over SSH. " +
+					"YugabyteDB Anywhere default is used if not set.",
+			},
+			"delete_backup": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				Description: "Delete the backup from storage while destroying the resource. " +
+					"False by default, in which case the backup is only removed from the " +
+					"terraform state.",
+			},
+			"state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "State of the backup.",
+			},
+			"create_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Creation time of the backup.",
+			},
+			"expiry_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Time at which the backup expires and is deleted from storage.",
+			},
+			"storage_locations": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Storage locations of the backed up keyspaces.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"keyspace": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Keyspace name.",
+						},
+						"storage_location": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Storage location of the keyspace backup.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceOnDemandBackupDiff() schema.CustomizeDiffFunc {
+	return customdiff.All(
+		customdiff.ValidateValue("time_before_delete", func(ctx context.Context, value,
+			meta interface{}) error {
+			if value.(string) != "" {
+				_, err := time.ParseDuration(value.(string))
+				if err != nil {
+					return fmt.Errorf("Backup Expiry Time: %w", err)
+				}
+			}
+			return nil
+		}),
+		customdiff.IfValueChange("time_before_delete",
+			func(ctx context.Context, old, new, meta interface{}) bool {
+				return old.(string) != "" && new.(string) == ""
+			},
+			func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+				return fmt.Errorf("Backup Expiry Time: time_before_delete cannot be removed " +
+					"once set, YugabyteDB Anywhere does not allow removing the expiry of a backup")
+			}),
+	)
+}
+
+func resourceBackupCreate(
+	ctx context.Context,
+	d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	c := meta.(*api.APIClient).YugawareClient
+	cUUID := meta.(*api.APIClient).CustomerID
+
+	allowed, version, err := backupYBAVersionCheck(ctx, c)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if !allowed {
+		return diag.FromErr(fmt.Errorf("Creating backups below version %s (or on restricted"+
+			" versions) is not supported, currently on %s", utils.YBAAllowBackupMinVersion,
+			version))
+	}
+
+	uUUID := d.Get("universe_uuid").(string)
+	keyspaceTableList := buildKeyspaceTableList(d.Get("keyspace_table").([]interface{}))
+
+	req := client.BackupRequestParams{
+		StorageConfigUUID: d.Get("storage_config_uuid").(string),
+		Sse:               utils.GetBoolPointer(d.Get("sse").(bool)),
+		BackupType:        utils.GetStringPointer(d.Get("backup_type").(string)),
+		KeyspaceTableList: &keyspaceTableList,
+		UniverseUUID:      uUUID,
+	}
+	// backups without an expiry are kept indefinitely
+	if d.Get("time_before_delete").(string) != "" {
+		timeBeforeDelete, timeBeforeDeleteUnit, _, err := utils.GetMsFromDurationString(
+			d.Get("time_before_delete").(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		req.TimeBeforeDelete = utils.GetInt64Pointer(timeBeforeDelete)
+		req.ExpiryTimeUnit = utils.GetStringPointer(timeBeforeDeleteUnit)
+	}
+	if parallelism, isPresent := d.GetOk("parallelism"); isPresent {
+		req.Parallelism = utils.GetInt32Pointer(int32(parallelism.(int)))
+	}
+
+	r, response, err := c.BackupsApi.Createbackup(ctx, cUUID).Backup(req).Execute()
+	if err != nil {
+		errMessage := utils.ErrorFromHTTPResponse(response, err, utils.ResourceEntity,
+			"Backup", "Create")
+		return diag.FromErr(errMessage)
+	}
+
+	// the backup is tracked before waiting, so that a failed or timed out task leaves a
+	// tainted backup in the state instead of an untracked one
+	bUUID, err := fetchBackupUUIDByTask(ctx, c, cUUID, uUUID, r.GetTaskUUID())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if bUUID != "" {
+		d.SetId(bUUID)
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Waiting for backup of universe %s to complete", uUUID))
+	err = utils.WaitForTask(ctx, r.GetTaskUUID(), cUUID, c, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.Id() == "" {
+		bUUID, err = fetchBackupUUIDByTask(ctx, c, cUUID, uUUID, r.GetTaskUUID())
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if bUUID == "" {
+			return diag.Errorf("Can't find backup created by task %s", r.GetTaskUUID())
+		}
+		d.SetId(bUUID)
+	}
+	return resourceBackupRead(ctx, d, meta)
+}
+
+// fetchBackupUUIDByTask returns the UUID of the backup created by the task, empty if the task
+// has not created it yet
+func fetchBackupUUIDByTask(ctx context.Context, c *client.APIClient, cUUID, uUUID,
+	tUUID string) (string, error) {
+	backups, response, err := c.BackupsApi.FetchBackupsByTaskUUID(ctx, cUUID, uUUID,
+		tUUID).Execute()
+	if err != nil {
+		errMessage := utils.ErrorFromHTTPResponse(response, err, utils.ResourceEntity,
+			"Backup", "Create - Fetch Backup")
+		return "", errMessage
+	}
+	if len(backups) == 0 {
+		return "", nil
+	}
+	return backups[0].GetBackupUUID(), nil
+}
+
+func resourceBackupRead(
+	ctx context.Context,
+	d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := meta.(*api.APIClient).YugawareClient
+	cUUID := meta.(*api.APIClient).CustomerID
+
+	b, response, err := c.BackupsApi.GetBackupV2(ctx, cUUID, d.Id()).Execute()
+	if err != nil {
+		errMessage := utils.ErrorFromHTTPResponse(response, err, utils.ResourceEntity,
+			"Backup", "Read")
+		return diag.FromErr(errMessage)
+	}
+
+	info := b.GetBackupInfo()
+	if err = d.Set("universe_uuid", info.GetUniverseUUID()); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("storage_config_uuid", b.GetStorageConfigUUID()); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("backup_type", info.GetBackupType()); err != nil {
+		return diag.FromErr(err)
+	}
+	keyspaceTables := flattenBackupKeyspaceTables(info,
+		d.Get("keyspace_table").([]interface{}))
+	if err = d.Set("keyspace_table", keyspaceTables); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("sse", info.GetSse()); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("parallelism", info.GetParallelism()); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("state", b.GetState()); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("create_time", formatBackupTime(b.CreateTime)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("expiry_time", formatBackupTime(b.Expiry)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("storage_locations", flattenBackupStorageLocations(info)); err != nil {
+		return diag.FromErr(err)
+	}
+	return diags
+}
+
+func formatBackupTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
+
+// flattenBackupKeyspaceTables returns the keyspace_table blocks of the backup. Full backups
+// have no blocks. Table names are reported unless the block in the state lists table UUIDs,
+// since YugabyteDB Anywhere returns both for table level backups.
+func flattenBackupKeyspaceTables(info client.BackupTableParams,
+	keyspaceTables []interface{}) []map[string]interface{} {
+	res := make([]map[string]interface{}, 0)
+	if info.GetIsFullBackup() {
+		return res
+	}
+	backupList := info.GetBackupList()
+	if len(backupList) == 0 {
+		backupList = append(backupList, info)
+	}
+	for _, b := range backupList {
+		if b.GetKeyspace() == "" {
+			continue
+		}
+		tableNames := make([]string, 0)
+		tableUUIDs := make([]string, 0)
+		if !b.GetAllTables() {
+			tableNames = b.GetTableNameList()
+			for _, k := range keyspaceTables {
+				keyspaceTable := k.(map[string]interface{})
+				if keyspaceTable["keyspace"] != b.GetKeyspace() {
+					continue
+				}
+				if len(keyspaceTable["table_uuid_list"].([]interface{})) > 0 {
+					tableUUIDs = b.GetTableUUIDList()
+					if len(keyspaceTable["table_name_list"].([]interface{})) == 0 {
+						tableNames = make([]string, 0)
+					}
+				}
+			}
+		}
+		res = append(res, map[string]interface{}{
+			"keyspace":        b.GetKeyspace(),
+			"table_name_list": tableNames,
+			"table_uuid_list": tableUUIDs,
+		})
+	}
+	return res
+}
+
+func flattenBackupStorageLocations(info client.BackupTableParams) []map[string]interface{} {
+	res := make([]map[string]interface{}, 0)
+	backupList := info.GetBackupList()
+	if len(backupList) == 0 {
+		backupList = append(backupList, info)
+	}
+	for _, b := range backupList {
+		if b.GetStorageLocation() == "" {
+			continue
+		}
+		res = append(res, map[string]interface{}{
+			"keyspace":         b.GetKeyspace(),
+			"storage_location": b.GetStorageLocation(),
+		})
+	}
+	return res
+}
+
+func resourceBackupUpdate(
+	ctx context.Context,
+	d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	c := meta.(*api.APIClient).YugawareClient
+	cUUID := meta.(*api.APIClient).CustomerID
+
+	// removing time_before_delete is rejected while planning
+	if d.HasChange("time_before_delete") && d.Get("time_before_delete").(string) != "" {
+		timeBeforeDelete, timeBeforeDeleteUnit, _, err := utils.GetMsFromDurationString(
+			d.Get("time_before_delete").(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		req := client.EditBackupParams{
+			TimeBeforeDeleteFromPresentInMillis: &timeBeforeDelete,
+			ExpiryTimeUnit:                      utils.GetStringPointer(timeBeforeDeleteUnit),
+		}
+		_, response, err := c.BackupsApi.EditBackupV2(ctx, cUUID, d.Id()).Backup(req).Execute()
+		if err != nil {
+			errMessage := utils.ErrorFromHTTPResponse(response, err, utils.ResourceEntity,
+				"Backup", "Update")
+			return diag.FromErr(errMessage)
+		}
+	}
+	return resourceBackupRead(ctx, d, meta)
+}
+
+func resourceBackupDelete(
+	ctx context.Context,
+	d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	c := meta.(*api.APIClient).YugawareClient
+	cUUID := meta.(*api.APIClient).CustomerID
+
+	if d.Get("delete_backup").(bool) {
+		req := client.DeleteBackupParams{
+			DeleteBackupInfos: []client.DeleteBackupInfo{
+				{
+					BackupUUID:        d.Id(),
+					StorageConfigUUID: utils.GetStringPointer(d.Get("storage_config_uuid").(string)),
+				},
+			},
+		}
+		_, response, err := c.BackupsApi.DeleteBackupsV2(ctx, cUUID).DeleteBackup(req).Execute()
+		if err != nil {
+			errMessage := utils.ErrorFromHTTPResponse(response, err, utils.ResourceEntity,
+				"Backup", "Delete")
+			return diag.FromErr(errMessage)
+		}
+		tflog.Info(ctx, fmt.Sprintf("Deleted backup %s", d.Id()))
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,333 @@
+// Licensed to YugabyteDB, Inc. under one or more contributor license
+// agreements. See the NOTICE file distributed with this work for
+// additional information regarding copyright ownership. Yugabyte
+// licenses this file to you under the Mozilla License, Version 2.0
+// (the "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+// http://mozilla.org/MPL/2.0/.
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package backups
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	client "github.com/yugabyte/platform-go-client"
+	"github.com/yugabyte/terraform-provider-yba/internal/api"
+	"github.com/yugabyte/terraform-provider-yba/internal/utils"
+	"golang.org/x/exp/slices"
+)
+
+// ResourceBackupRetention enforces a retention policy on the backups of a universe
+func ResourceBackupRetention() *schema.Resource {
+	return &schema.Resource{
+		Description: "Retention policy for the completed backups of a universe. Backups " +
+			"that fall outside the policy are listed in pending_deletion. When " +
+			"confirm_deletion is set, the plan lists the backups to be deleted in " +
+			"deleted_backups and the apply deletes exactly those backups. Removing the " +
+			"resource stops enforcing the policy without deleting any backups.",
+
+		CreateContext: resourceBackupRetentionCreate,
+		ReadContext:   resourceBackupRetentionRead,
+		UpdateContext: resourceBackupRetentionUpdate,
+		DeleteContext: resourceBackupRetentionDelete,
+
+		CustomizeDiff: resourceBackupRetentionDiff(),
+
+		Schema: map[string]*schema.Schema{
+			"universe_uuid": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The UUID of the universe whose backups are retained.",
+			},
+			"keep_last": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				AtLeastOneOf: []string{"keep_last", "max_age"},
+				Description: "Number of latest backups that are always retained. If " +
+					"max_age is not set, all older backups are deleted.",
+			},
+			"max_age": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				AtLeastOneOf: []string{"keep_last", "max_age"},
+				Description: "Backups older than this duration are deleted, except for the " +
+					"latest keep_last backups. Accepts string duration in the standard " +
+					"format <https://pkg.go.dev/time#Duration>.",
+			},
+			"keyspaces": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Description: "Only backups containing these keyspaces are subject to the " +
+					"policy. All backups of the universe are if not set.",
+			},
+			"confirm_deletion": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				Description: "Delete the backups that fall outside the policy. The backups " +
+					"are listed in deleted_backups in the plan, and only those backups are " +
+					"deleted on apply. False by default, in which case backups are only " +
+					"reported in pending_deletion.",
+			},
+			"pending_deletion": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "UUIDs of the backups that fall outside the policy.",
+			},
+			"deleted_backups": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Description: "UUIDs of the backups deleted by the last apply, as listed in the " +
+					"plan. Backups that no longer fall outside the policy at apply time are " +
+					"not deleted.",
+			},
+		},
+	}
+}
+
+func resourceBackupRetentionDiff() schema.CustomizeDiffFunc {
+	return customdiff.All(
+		customdiff.ValidateValue("max_age", func(ctx context.Context, value,
+			meta interface{}) error {
+			if value.(string) != "" {
+				_, err := time.ParseDuration(value.(string))
+				if err != nil {
+					return fmt.Errorf("Backup Retention Max Age: %w", err)
+				}
+			}
+			return nil
+		}),
+		func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+			// the backups to delete are resolved while planning so that the plan lists
+			// exactly the backups deleted by the apply
+			if !d.Get("confirm_deletion").(bool) || !d.NewValueKnown("universe_uuid") ||
+				!d.NewValueKnown("keyspaces") {
+				if d.Id() == "" {
+					return d.SetNew("deleted_backups", []string{})
+				}
+				return nil
+			}
+			c := meta.(*api.APIClient).YugawareClient
+			cUUID := meta.(*api.APIClient).CustomerID
+			expired, err := findExpiredBackups(ctx, c, cUUID, d.Get("universe_uuid").(string),
+				*utils.StringSlice(d.Get("keyspaces").([]interface{})),
+				d.Get("keep_last").(int), d.Get("max_age").(string))
+			if err != nil {
+				return err
+			}
+			if len(expired) == 0 {
+				if d.Id() == "" {
+					return d.SetNew("deleted_backups", []string{})
+				}
+				return nil
+			}
+			return d.SetNew("deleted_backups", backupUUIDs(expired))
+		},
+	)
+}
+
+func resourceBackupRetentionCreate(
+	ctx context.Context,
+	d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	d.SetId(uuid.New().String())
+	if err := applyBackupRetention(ctx, d, meta); err != nil {
+		return diag.FromErr(err)
+	}
+	return resourceBackupRetentionRead(ctx, d, meta)
+}
+
+func resourceBackupRetentionRead(
+	ctx context.Context,
+	d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := meta.(*api.APIClient).YugawareClient
+	cUUID := meta.(*api.APIClient).CustomerID
+
+	expired, err := findExpiredBackups(ctx, c, cUUID, d.Get("universe_uuid").(string),
+		*utils.StringSlice(d.Get("keyspaces").([]interface{})), d.Get("keep_last").(int),
+		d.Get("max_age").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("pending_deletion", backupUUIDs(expired)); err != nil {
+		return diag.FromErr(err)
+	}
+	return diags
+}
+
+func resourceBackupRetentionUpdate(
+	ctx context.Context,
+	d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	if d.HasChange("deleted_backups") {
+		if err := applyBackupRetention(ctx, d, meta); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	return resourceBackupRetentionRead(ctx, d, meta)
+}
+
+func resourceBackupRetentionDelete(
+	ctx context.Context,
+	d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	d.SetId("")
+	return nil
+}
+
+// applyBackupRetention deletes the backups listed in the plan that still fall outside the
+// retention policy. Backups created after the plan are never deleted.
+func applyBackupRetention(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*api.APIClient).YugawareClient
+	cUUID := meta.(*api.APIClient).CustomerID
+
+	planned := *utils.StringSlice(d.Get("deleted_backups").([]interface{}))
+	if len(planned) == 0 {
+		return nil
+	}
+
+	allowed, version, err := backupYBAVersionCheck(ctx, c)
+	if err != nil {
+		return err
+	}
+
+	if !allowed {
+		return fmt.Errorf("Deleting backups below version %s (or on restricted versions) "+
+			"is not supported, currently on %s", utils.YBAAllowBackupMinVersion, version)
+	}
+
+	expired, err := findExpiredBackups(ctx, c, cUUID, d.Get("universe_uuid").(string),
+		*utils.StringSlice(d.Get("keyspaces").([]interface{})), d.Get("keep_last").(int),
+		d.Get("max_age").(string))
+	if err != nil {
+		return err
+	}
+
+	deleteBackupInfos := make([]client.DeleteBackupInfo, 0)
+	deleted := make([]string, 0)
+	for _, b := range expired {
+		info := b.GetCommonBackupInfo()
+		if !slices.Contains(planned, info.BackupUUID) {
+			continue
+		}
+		deleteBackupInfos = append(deleteBackupInfos, client.DeleteBackupInfo{
+			BackupUUID:        info.BackupUUID,
+			StorageConfigUUID: utils.GetStringPointer(info.StorageConfigUUID),
+		})
+		deleted = append(deleted, info.BackupUUID)
+	}
+	for _, bUUID := range planned {
+		if !slices.Contains(deleted, bUUID) {
+			tflog.Warn(ctx, fmt.Sprintf("Backup %s no longer falls outside the retention "+
+				"policy, skipping deletion", bUUID))
+		}
+	}
+	if len(deleteBackupInfos) == 0 {
+		return nil
+	}
+
+	req := client.DeleteBackupParams{
+		DeleteBackupInfos: deleteBackupInfos,
+	}
+	_, response, err := c.BackupsApi.DeleteBackupsV2(ctx, cUUID).DeleteBackup(req).Execute()
+	if err != nil {
+		return utils.ErrorFromHTTPResponse(response, err, utils.ResourceEntity,
+			"Backup Retention", "Delete Backups")
+	}
+	tflog.Info(ctx, fmt.Sprintf("Deleted %d backups of universe %s: %v", len(deleted),
+		d.Get("universe_uuid").(string), deleted))
+	return nil
+}
+
+// findExpiredBackups lists the completed backups of the universe that fall outside the
+// retention policy, newest first
+func findExpiredBackups(ctx context.Context, c *client.APIClient, cUUID, uUUID string,
+	keyspaces []string, keepLast int, maxAge string) ([]client.BackupResp, error) {
+	var minTime = time.Unix(-2208988800, 0) // Jan 1, 1900
+	var maxTime = minTime.Add(1<<63 - 1)
+
+	startDate, err := time.Parse(time.RFC3339, minTime.Format(time.RFC3339))
+	if err != nil {
+		return nil, err
+	}
+	endDate, err := time.Parse(time.RFC3339, maxTime.Format(time.RFC3339))
+	if err != nil {
+		return nil, err
+	}
+
+	var cutoff *time.Time
+	if maxAge != "" {
+		age, err := time.ParseDuration(maxAge)
+		if err != nil {
+			return nil, err
+		}
+		t := time.Now().Add(-age)
+		cutoff = &t
+	}
+
+	req := client.BackupPagedApiQuery{
+		Filter: client.BackupApiFilter{
+			UniverseUUIDList: []string{uUUID},
+			States:           []string{"Completed"},
+			KeyspaceList:     keyspaces,
+			DateRangeStart:   &startDate,
+			DateRangeEnd:     &endDate,
+		},
+		SortBy:    "createTime",
+		Direction: "DESC",
+		Limit:     backupsPageLimit,
+	}
+	backups, err := listBackups(ctx, c, cUUID, req, utils.ResourceEntity)
+	if err != nil {
+		return nil, err
+	}
+	return selectExpiredBackups(backups, keepLast, cutoff), nil
+}
+
+// selectExpiredBackups returns the backups, sorted newest first, that are not among the
+// latest keepLast backups and were created before the cutoff, if any
+func selectExpiredBackups(backups []client.BackupResp, keepLast int,
+	cutoff *time.Time) []client.BackupResp {
+	expired := make([]client.BackupResp, 0)
+	for i, b := range backups {
+		if i < keepLast {
+			continue
+		}
+		createTime := b.GetCommonBackupInfo().CreateTime
+		if cutoff != nil && (createTime == nil || createTime.After(*cutoff)) {
+			continue
+		}
+		expired = append(expired, b)
+	}
+	return expired
+}
+
+func backupUUIDs(backups []client.BackupResp) []string {
+	res := make([]string, 0)
+	for _, b := range backups {
+		res = append(res, b.GetCommonBackupInfo().BackupUUID)
+	}
+	return res
+}
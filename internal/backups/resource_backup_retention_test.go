@@ -0,0 +1,58 @@
+// Licensed to YugabyteDB, Inc. under one or more contributor license
+// agreements. See the NOTICE file distributed with this work for
+// additional information regarding copyright ownership. Yugabyte
+// licenses this file to you under the Mozilla License, Version 2.0
+// (the "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+// http://mozilla.org/MPL/2.0/.
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package backups
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	client "github.com/yugabyte/platform-go-client"
+)
+
+func TestSelectExpiredBackups(t *testing.T) {
+	now := time.Now()
+	hourAgo := now.Add(-time.Hour)
+	dayAgo := now.Add(-24 * time.Hour)
+	twoDaysAgo := now.Add(-48 * time.Hour)
+	cutoff := now.Add(-36 * time.Hour)
+
+	// newest first, as returned by the backup list
+	backups := []client.BackupResp{
+		{CommonBackupInfo: client.CommonBackupInfo{BackupUUID: "b1", CreateTime: &hourAgo}},
+		{CommonBackupInfo: client.CommonBackupInfo{BackupUUID: "b2", CreateTime: &dayAgo}},
+		{CommonBackupInfo: client.CommonBackupInfo{BackupUUID: "b3", CreateTime: &twoDaysAgo}},
+		{CommonBackupInfo: client.CommonBackupInfo{BackupUUID: "b4"}},
+	}
+
+	tests := []struct {
+		name     string
+		keepLast int
+		cutoff   *time.Time
+		expected []string
+	}{
+		{"keep last", 2, nil, []string{"b3", "b4"}},
+		{"max age skips backups without create time", 0, &cutoff, []string{"b3"}},
+		{"keep last protects old backups", 3, &cutoff, []string{}},
+	}
+
+	for _, tc := range tests {
+		got := backupUUIDs(selectExpiredBackups(backups, tc.keepLast, tc.cutoff))
+		if !reflect.DeepEqual(got, tc.expected) {
+			t.Errorf("%s: selectExpiredBackups() = %v, expected %v", tc.name, got, tc.expected)
+		}
+	}
+}
@@ -111,11 +111,14 @@ func ResourceBackups() *schema.Resource {
 					"standard format <https://pkg.go.dev/time#Duration>.",
 			},
 			"keyspace": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				ForceNew:    true,
-				Description: "Keyspace to backup.",
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"keyspace_table"},
+				Deprecated:    "Please use keyspace_table block instead.",
+				Description:   "Keyspace to backup. Please use keyspace_table block instead.",
 			},
+			"keyspace_table": KeyspaceTableSchema(),
 			"storage_config_uuid": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -129,7 +132,7 @@ func ResourceBackups() *schema.Resource {
 				ForceNew: true,
 				Description: "Time before deleting the backup from storage. Accepts " +
 					"string duration in the standard format <https://pkg.go.dev/time#Duration>. " +
-					"Backups are kept indefinitely if not set.",
+					"Backups are kept indefinitely if not set. Changing it recreates the schedule.",
 			},
 			"sse": {
 				Type:        schema.TypeBool,
@@ -147,7 +150,8 @@ func ResourceBackups() *schema.Resource {
 				Type:        schema.TypeInt,
 				Optional:    true,
 				ForceNew:    true,
-				Description: "Number of concurrent commands to run on nodes over SSH.",
+				Description: "Number of concurrent commands to run on nodes over SSH. " +
+					"Changing it recreates the schedule.",
 			},
 			"backup_type": {
 				Type:     schema.TypeString,
@@ -159,11 +163,13 @@ func ResourceBackups() *schema.Resource {
 					"REDIS_TABLE_TYPE, PGSQL_TABLE_TYPE.",
 			},
 			"table_uuid_list": {
-				Type:        schema.TypeList,
-				Optional:    true,
-				Elem:        &schema.Schema{Type: schema.TypeString},
-				ForceNew:    true,
-				Description: "List of Table UUIDs.",
+				Type:          schema.TypeList,
+				Optional:      true,
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				ForceNew:      true,
+				ConflictsWith: []string{"keyspace_table"},
+				Deprecated:    "Please use keyspace_table block instead.",
+				Description:   "List of Table UUIDs. Please use keyspace_table block instead.",
 			},
 			"delete_backup": {
 				Type:        schema.TypeBool,
@@ -171,6 +177,17 @@ func ResourceBackups() *schema.Resource {
 				Default:     false,
 				Description: "Delete backup while deleting schedule. False by default.",
 			},
+			"status": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "Active",
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(
+					[]string{"Active", "Paused"}, false)),
+				Description: "Status of the backup schedule. Set to Paused to stop taking " +
+					"scheduled backups without deleting the schedule. Permitted values: " +
+					"Active, Paused. Active by default. Schedules created as Paused are " +
+					"created Active and paused immediately afterwards.",
+			},
 			"incremental_backup_frequency": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -291,12 +308,14 @@ func resourceBackupsCreate(
 	if d.Get("schedule_name").(string) == "" {
 		return diag.FromErr(errors.New("V2 Schedules require a name"))
 	}
-	keyspaceTableList := make([]client.KeyspaceTable, 0)
-	keyspaceTable := client.KeyspaceTable{
-		Keyspace:      utils.GetStringPointer(d.Get("keyspace").(string)),
-		TableUUIDList: utils.StringSlice(d.Get("table_uuid_list").([]interface{})),
+	keyspaceTableList := buildKeyspaceTableList(d.Get("keyspace_table").([]interface{}))
+	if len(keyspaceTableList) == 0 {
+		keyspaceTable := client.KeyspaceTable{
+			Keyspace:      utils.GetStringPointer(d.Get("keyspace").(string)),
+			TableUUIDList: utils.StringSlice(d.Get("table_uuid_list").([]interface{})),
+		}
+		keyspaceTableList = append(keyspaceTableList, keyspaceTable)
 	}
-	keyspaceTableList = append(keyspaceTableList, keyspaceTable)
 
 	var timeBeforeDelete, frequency, incrementalFrequency int64
 	var timeBeforeDeleteUnit, frequencyUnit, incrementalFrequencyUnit string
@@ -371,6 +390,25 @@ func resourceBackupsCreate(
 	}
 
 	d.SetId(r.GetScheduleUUID())
+
+	// the create schedule API does not accept a status, schedules are always created Active
+	// and paused afterwards
+	if d.Get("status").(string) != "Active" {
+		editReq, err := buildEditBackupScheduleParams(ctx, c, cUUID, d)
+		if err != nil {
+			diags := resourceBackupsRead(ctx, d, meta)
+			return append(diags, diag.FromErr(err)...)
+		}
+		_, response, err = c.ScheduleManagementApi.EditBackupScheduleV2(ctx,
+			cUUID, d.Id()).Body(editReq).Execute()
+		if err != nil {
+			errMessage := utils.ErrorFromHTTPResponse(response, err, utils.ResourceEntity,
+				"Backups", "Create - Set Schedule Status")
+			// the state records the schedule as Active
+			diags := resourceBackupsRead(ctx, d, meta)
+			return append(diags, diag.FromErr(errMessage)...)
+		}
+	}
 	return resourceBackupsRead(ctx, d, meta)
 }
 
@@ -400,7 +438,7 @@ func resourceBackupsRead(
 		Direction: "DESC",
 		Limit:     *utils.GetInt32Pointer(10),
 		Filter: client.ScheduleApiFilter{
-			Status: *utils.StringSlice(utils.CreateSingletonList("Active")),
+			Status: []string{"Active", "Paused"},
 			UniverseUUIDList: *utils.StringSlice(utils.CreateSingletonList(
 				d.Get("universe_uuid"))),
 		},
@@ -427,6 +465,27 @@ func resourceBackupsRead(
 		return diag.FromErr(err)
 	}
 
+	if err = d.Set("status", b.GetStatus()); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Keyspaces are read back only when the keyspace_table block is in use, or
+	// when no keyspace is known (such as on import)
+	if len(d.Get("keyspace_table").([]interface{})) > 0 ||
+		(d.Get("keyspace").(string) == "" &&
+			len(d.Get("table_uuid_list").([]interface{})) == 0) {
+		vc := meta.(*api.APIClient).VanillaClient
+		token := meta.(*api.APIClient).APIKey
+		taskParams, err := vc.GetScheduleTaskParams(ctx, cUUID, d.Id(), token)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		err = d.Set("keyspace_table", flattenKeyspaceTableList(taskParams.KeyspaceTableList))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return diags
 }
 
@@ -465,72 +524,94 @@ func resourceBackupsUpdate(
 
 	tflog.Info(ctx, fmt.Sprintf("Current version %s, using V2 Edit Schedule Backup API", version))
 
-	if d.HasChange("frequency") || d.HasChange("cron_expression") ||
-		d.HasChange("incremental_backup_frequency") {
+	if d.HasChanges(editableScheduleFields()...) {
+		req, err := buildEditBackupScheduleParams(ctx, c, cUUID, d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		_, response, err := c.ScheduleManagementApi.EditBackupScheduleV2(ctx,
+			cUUID, d.Id()).Body(req).Execute()
+		if err != nil {
+			errMessage := utils.ErrorFromHTTPResponse(response, err, utils.ResourceEntity,
+				"Backups", "Update")
+			return diag.FromErr(errMessage)
+		}
+	}
+	return resourceBackupsRead(ctx, d, meta)
+}
 
-		var frequency, incrementalFrequency int64
-		var frequencyUnit, incrementalFrequencyUnit string
-		var frequencyGiven, incrementalFrequencyGiven bool
+// editableScheduleFields lists the fields of a backup schedule accepted by the edit backup
+// schedule API (EditBackupScheduleParams). The expiry, parallelism and keyspaces of the
+// backups are not part of the API, so changes to the remaining fields, apart from
+// delete_backup, require the schedule to be recreated.
+func editableScheduleFields() []string {
+	return []string{"cron_expression", "frequency", "incremental_backup_frequency", "status"}
+}
 
-		if d.Get("frequency") != "" && d.Get("frequency") != "0" {
-			if d.HasChange("frequency") {
-				frequency, frequencyUnit, frequencyGiven, err = utils.
-					GetMsFromDurationString(d.Get("frequency").(string))
-				if err != nil {
-					return diag.FromErr(err)
-				}
-				if frequency < utils.ConvertUnitToMs(1, "HOURS") {
-					return diag.Errorf("Frequency of backups cannot be less than 1 hour")
-				}
-			} else {
-				r, response, err := c.ScheduleManagementApi.GetSchedule(ctx, cUUID, d.Id()).Execute()
-				if err != nil {
-					errMessage := utils.ErrorFromHTTPResponse(response, err, utils.ResourceEntity,
-						"Backups", "Update - Fetch Backup Schedule")
-					return diag.FromErr(errMessage)
-				}
-				frequency = r.GetFrequency()
-				frequencyGiven = true
-				frequencyUnit = r.GetFrequencyTimeUnit()
-			}
-		}
+// buildEditBackupScheduleParams populates every editable field from the configuration,
+// so that editing one of them does not clear the others
+func buildEditBackupScheduleParams(ctx context.Context, c *client.APIClient, cUUID string,
+	d *schema.ResourceData) (client.EditBackupScheduleParams, error) {
+	var frequency, incrementalFrequency int64
+	var frequencyUnit, incrementalFrequencyUnit string
+	var frequencyGiven, incrementalFrequencyGiven bool
+	var err error
 
-		if d.Get("incremental_backup_frequency") != "" {
-			incrementalFrequency, incrementalFrequencyUnit, incrementalFrequencyGiven, err = utils.
-				GetMsFromDurationString(d.Get("incremental_backup_frequency").(string))
+	if d.Get("frequency") != "" && d.Get("frequency") != "0" {
+		if d.HasChange("frequency") {
+			frequency, frequencyUnit, frequencyGiven, err = utils.
+				GetMsFromDurationString(d.Get("frequency").(string))
 			if err != nil {
-				return diag.FromErr(err)
+				return client.EditBackupScheduleParams{}, err
 			}
-		}
-
-		if frequencyGiven && incrementalFrequencyGiven {
-			if incrementalFrequency > frequency {
-				return diag.Errorf(
-					"Frequency of incremental backups cannot be more than frequency of full backups")
+			if frequency < utils.ConvertUnitToMs(1, "HOURS") {
+				return client.EditBackupScheduleParams{},
+					errors.New("Frequency of backups cannot be less than 1 hour")
 			}
-		} else if incrementalFrequencyGiven {
-			if incrementalFrequency > utils.ConvertUnitToMs(1, "DAYS") {
-				return diag.Errorf("Frequency of incremental backups cannot be more than 1 day")
+		} else {
+			// frequency in the state may have been read back in milliseconds, use the
+			// value stored in YugabyteDB Anywhere
+			r, response, err := c.ScheduleManagementApi.GetSchedule(ctx, cUUID, d.Id()).Execute()
+			if err != nil {
+				errMessage := utils.ErrorFromHTTPResponse(response, err, utils.ResourceEntity,
+					"Backups", "Update - Fetch Backup Schedule")
+				return client.EditBackupScheduleParams{}, errMessage
 			}
+			frequency = r.GetFrequency()
+			frequencyGiven = true
+			frequencyUnit = r.GetFrequencyTimeUnit()
 		}
+	}
 
-		req := client.EditBackupScheduleParams{
-			CronExpression: utils.GetStringPointer(
-				d.Get("cron_expression").(string)),
-			Frequency:                          utils.GetInt64Pointer(frequency),
-			FrequencyTimeUnit:                  utils.GetStringPointer(frequencyUnit),
-			IncrementalBackupFrequency:         utils.GetInt64Pointer(incrementalFrequency),
-			IncrementalBackupFrequencyTimeUnit: utils.GetStringPointer(incrementalFrequencyUnit),
-		}
-		_, response, err := c.ScheduleManagementApi.EditBackupScheduleV2(ctx,
-			cUUID, d.Id()).Body(req).Execute()
+	if d.Get("incremental_backup_frequency") != "" {
+		incrementalFrequency, incrementalFrequencyUnit, incrementalFrequencyGiven, err = utils.
+			GetMsFromDurationString(d.Get("incremental_backup_frequency").(string))
 		if err != nil {
-			errMessage := utils.ErrorFromHTTPResponse(response, err, utils.ResourceEntity,
-				"Backups", "Update")
-			return diag.FromErr(errMessage)
+			return client.EditBackupScheduleParams{}, err
 		}
 	}
-	return resourceBackupsRead(ctx, d, meta)
+
+	if frequencyGiven && incrementalFrequencyGiven {
+		if incrementalFrequency > frequency {
+			return client.EditBackupScheduleParams{}, errors.New(
+				"Frequency of incremental backups cannot be more than frequency of full backups")
+		}
+	} else if incrementalFrequencyGiven {
+		if incrementalFrequency > utils.ConvertUnitToMs(1, "DAYS") {
+			return client.EditBackupScheduleParams{},
+				errors.New("Frequency of incremental backups cannot be more than 1 day")
+		}
+	}
+
+	return client.EditBackupScheduleParams{
+		CronExpression: utils.GetStringPointer(
+			d.Get("cron_expression").(string)),
+		Frequency:                          utils.GetInt64Pointer(frequency),
+		FrequencyTimeUnit:                  utils.GetStringPointer(frequencyUnit),
+		IncrementalBackupFrequency:         utils.GetInt64Pointer(incrementalFrequency),
+		IncrementalBackupFrequencyTimeUnit: utils.GetStringPointer(incrementalFrequencyUnit),
+		Status:                             utils.GetStringPointer(d.Get("status").(string)),
+	}, nil
 }
 
 func resourceBackupsDelete(
@@ -0,0 +1,300 @@
+// Licensed to YugabyteDB, Inc. under one or more contributor license
+// agreements. See the NOTICE file distributed with this work for
+// additional information regarding copyright ownership. Yugabyte
+// licenses this file to you under the Mozilla License, Version 2.0
+// (the "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+// http://mozilla.org/MPL/2.0/.
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package backups
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	client "github.com/yugabyte/platform-go-client"
+	"github.com/yugabyte/terraform-provider-yba/internal/api"
+	"github.com/yugabyte/terraform-provider-yba/internal/utils"
+)
+
+// ResourcePitrConfig creates and maintains point-in-time recovery configurations
+func ResourcePitrConfig() *schema.Resource {
+	return &schema.Resource{
+		Description: "Point-in-time recovery (PITR) configuration for a keyspace or " +
+			"database of a universe.",
+
+		CreateContext: resourcePitrConfigCreate,
+		ReadContext:   resourcePitrConfigRead,
+		DeleteContext: resourcePitrConfigDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		CustomizeDiff: resourcePitrConfigDiff(),
+
+		Schema: map[string]*schema.Schema{
+			"universe_uuid": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The UUID of the universe to enable PITR on.",
+			},
+			"keyspace_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the keyspace (YCQL) or database (YSQL) to enable PITR on.",
+			},
+			"table_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(
+					[]string{"YQL_TABLE_TYPE", "PGSQL_TABLE_TYPE"}, false)),
+				Description: "Type of the tables in the keyspace. Permitted values: " +
+					"YQL_TABLE_TYPE, PGSQL_TABLE_TYPE.",
+			},
+			"retention_period": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Description: "Duration for which snapshots are retained, which is the " +
+					"window available for recovery. Accepts string duration in the standard " +
+					"format <https://pkg.go.dev/time#Duration>. YugabyteDB Anywhere defaults " +
+					"to 7 days if not set.",
+			},
+			"schedule_interval": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Description: "Interval between snapshots. Accepts string duration in the " +
+					"standard format <https://pkg.go.dev/time#Duration>. YugabyteDB Anywhere " +
+					"defaults to 1 day if not set.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Name of the PITR configuration.",
+			},
+			"state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "State of the PITR configuration.",
+			},
+			"min_recover_time_in_millis": {
+				Type:     schema.TypeInt,
+				Computed: true,
+				Description: "Earliest time, in milliseconds since epoch, to which the " +
+					"keyspace can be recovered.",
+			},
+			"max_recover_time_in_millis": {
+				Type:     schema.TypeInt,
+				Computed: true,
+				Description: "Latest time, in milliseconds since epoch, to which the " +
+					"keyspace can be recovered.",
+			},
+		},
+	}
+}
+
+func resourcePitrConfigDiff() schema.CustomizeDiffFunc {
+	return customdiff.All(
+		customdiff.ValidateValue("retention_period", func(ctx context.Context, value,
+			meta interface{}) error {
+			if value.(string) != "" {
+				_, err := time.ParseDuration(value.(string))
+				if err != nil {
+					return fmt.Errorf("PITR Retention Period: %w", err)
+				}
+			}
+			return nil
+		}),
+		customdiff.ValidateValue("schedule_interval", func(ctx context.Context, value,
+			meta interface{}) error {
+			if value.(string) != "" {
+				_, err := time.ParseDuration(value.(string))
+				if err != nil {
+					return fmt.Errorf("PITR Schedule Interval: %w", err)
+				}
+			}
+			return nil
+		}),
+		customdiff.IfValue("retention_period",
+			func(ctx context.Context, value, meta interface{}) bool {
+				return value.(string) != ""
+			},
+			func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+				interval := d.Get("schedule_interval").(string)
+				if interval == "" {
+					return nil
+				}
+				retention, err := time.ParseDuration(d.Get("retention_period").(string))
+				if err != nil {
+					return err
+				}
+				scheduleInterval, err := time.ParseDuration(interval)
+				if err != nil {
+					return err
+				}
+				if scheduleInterval >= retention {
+					return errors.New("PITR schedule interval must be less than the " +
+						"retention period")
+				}
+				return nil
+			}),
+	)
+}
+
+func resourcePitrConfigCreate(
+	ctx context.Context,
+	d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	c := meta.(*api.APIClient).YugawareClient
+	cUUID := meta.(*api.APIClient).CustomerID
+
+	allowed, version, err := backupYBAVersionCheck(ctx, c)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if !allowed {
+		return diag.FromErr(fmt.Errorf("Creating PITR configurations below version %s (or on "+
+			"restricted versions) is not supported, currently on %s",
+			utils.YBAAllowBackupMinVersion, version))
+	}
+
+	uUUID := d.Get("universe_uuid").(string)
+	keyspace := d.Get("keyspace_name").(string)
+	tableType := d.Get("table_type").(string)
+
+	// unset durations are left to the YugabyteDB Anywhere defaults
+	req := client.CreatePitrConfigParams{}
+	if d.Get("retention_period").(string) != "" {
+		duration, err := time.ParseDuration(d.Get("retention_period").(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		req.RetentionPeriodInSeconds = utils.GetInt64Pointer(int64(duration.Seconds()))
+	}
+	if d.Get("schedule_interval").(string) != "" {
+		duration, err := time.ParseDuration(d.Get("schedule_interval").(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		req.IntervalInSeconds = utils.GetInt64Pointer(int64(duration.Seconds()))
+	}
+
+	r, response, err := c.PITRManagementApi.CreatePitrConfig(ctx, cUUID, uUUID, tableType,
+		keyspace).PitrConfig(req).Execute()
+	if err != nil {
+		errMessage := utils.ErrorFromHTTPResponse(response, err, utils.ResourceEntity,
+			"PITR Config", "Create")
+		return diag.FromErr(errMessage)
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Waiting for PITR config on keyspace %s to be created",
+		keyspace))
+	err = utils.WaitForTask(ctx, r.GetTaskUUID(), cUUID, c, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	configs, response, err := c.PITRManagementApi.ListOfPitrConfigs(ctx, cUUID, uUUID).Execute()
+	if err != nil {
+		errMessage := utils.ErrorFromHTTPResponse(response, err, utils.ResourceEntity,
+			"PITR Config", "Create - Fetch PITR Configs")
+		return diag.FromErr(errMessage)
+	}
+	for _, p := range configs {
+		if p.GetDbName() == keyspace && p.GetTableType() == tableType {
+			d.SetId(p.GetUuid())
+			return resourcePitrConfigRead(ctx, d, meta)
+		}
+	}
+	return diag.Errorf("Can't find PITR config for keyspace %s in universe %s", keyspace, uUUID)
+}
+
+func resourcePitrConfigRead(
+	ctx context.Context,
+	d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := meta.(*api.APIClient).YugawareClient
+	cUUID := meta.(*api.APIClient).CustomerID
+
+	p, err := findPitrConfig(ctx, c, cUUID, d.Get("universe_uuid").(string), d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err = d.Set("keyspace_name", p.GetDbName()); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("table_type", p.GetTableType()); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("name", p.GetName()); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("state", p.GetState()); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("min_recover_time_in_millis", p.GetMinRecoverTimeInMillis()); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("max_recover_time_in_millis", p.GetMaxRecoverTimeInMillis()); err != nil {
+		return diag.FromErr(err)
+	}
+	return diags
+}
+
+func findPitrConfig(ctx context.Context, c *client.APIClient, cUUID, uUUID, pUUID string) (
+	client.PitrConfig, error) {
+	r, response, err := c.PITRManagementApi.ListOfPitrConfigs(ctx, cUUID, uUUID).Execute()
+	if err != nil {
+		errMessage := utils.ErrorFromHTTPResponse(response, err, utils.ResourceEntity,
+			"PITR Config", "Read")
+		return client.PitrConfig{}, errMessage
+	}
+	for _, p := range r {
+		if p.GetUuid() == pUUID {
+			return p, nil
+		}
+	}
+	return client.PitrConfig{}, fmt.Errorf("Can't find PITR config %s", pUUID)
+}
+
+func resourcePitrConfigDelete(
+	ctx context.Context,
+	d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	c := meta.(*api.APIClient).YugawareClient
+	cUUID := meta.(*api.APIClient).CustomerID
+
+	_, response, err := c.PITRManagementApi.DeletePitrConfig(ctx, cUUID,
+		d.Get("universe_uuid").(string), d.Id()).Execute()
+	if err != nil {
+		errMessage := utils.ErrorFromHTTPResponse(response, err, utils.ResourceEntity,
+			"PITR Config", "Delete")
+		return diag.FromErr(errMessage)
+	}
+
+	d.SetId("")
+	return nil
+}
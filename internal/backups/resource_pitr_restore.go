@@ -0,0 +1,149 @@
+// Licensed to YugabyteDB, Inc. under one or more contributor license
+// agreements. See the NOTICE file distributed with this work for
+// additional information regarding copyright ownership. Yugabyte
+// licenses this file to you under the Mozilla License, Version 2.0
+// (the "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+// http://mozilla.org/MPL/2.0/.
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package backups
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	client "github.com/yugabyte/platform-go-client"
+	"github.com/yugabyte/terraform-provider-yba/internal/api"
+	"github.com/yugabyte/terraform-provider-yba/internal/utils"
+)
+
+// ResourcePitrRestore to trigger a point-in-time recovery
+func ResourcePitrRestore() *schema.Resource {
+	return &schema.Resource{
+		Description: "Recover a keyspace to a point in time using its PITR configuration. " +
+			"This resource does not track the remote state and is only provided as a " +
+			"convenience tool. It is recommended to remove this resource after running " +
+			"terraform apply.",
+
+		CreateContext: resourcePitrRestoreCreate,
+		ReadContext:   resourcePitrRestoreRead,
+		DeleteContext: resourcePitrRestoreDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"universe_uuid": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The UUID of the universe to recover.",
+			},
+			"pitr_config_uuid": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "UUID of the PITR configuration of the keyspace to recover.",
+			},
+			"restore_time": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IsRFC3339Time),
+				Description: "Point in time to recover the keyspace to, in RFC3339 format. " +
+					"Must lie within the recovery window of the PITR configuration.",
+			},
+		},
+	}
+}
+
+func resourcePitrRestoreCreate(
+	ctx context.Context,
+	d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	c := meta.(*api.APIClient).YugawareClient
+	cUUID := meta.(*api.APIClient).CustomerID
+
+	allowed, version, err := backupYBAVersionCheck(ctx, c)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if !allowed {
+		return diag.FromErr(fmt.Errorf("Point-in-time recovery below version %s (or on "+
+			"restricted versions) is not supported, currently on %s",
+			utils.YBAAllowBackupMinVersion, version))
+	}
+
+	uUUID := d.Get("universe_uuid").(string)
+	pUUID := d.Get("pitr_config_uuid").(string)
+
+	restoreTime, err := time.Parse(time.RFC3339, d.Get("restore_time").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	restoreTimeInMillis := restoreTime.UnixMilli()
+
+	p, err := findPitrConfig(ctx, c, cUUID, uUUID, pUUID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if restoreTimeInMillis < p.GetMinRecoverTimeInMillis() ||
+		restoreTimeInMillis > p.GetMaxRecoverTimeInMillis() {
+		return diag.Errorf("Restore time %s is outside the recovery window of PITR config "+
+			"%s: %s to %s", restoreTime.Format(time.RFC3339), pUUID,
+			time.UnixMilli(p.GetMinRecoverTimeInMillis()).UTC().Format(time.RFC3339),
+			time.UnixMilli(p.GetMaxRecoverTimeInMillis()).UTC().Format(time.RFC3339))
+	}
+
+	req := client.RestoreSnapshotScheduleParams{
+		PitrConfigUUID:      utils.GetStringPointer(pUUID),
+		RestoreTimeInMillis: utils.GetInt64Pointer(restoreTimeInMillis),
+	}
+
+	r, response, err := c.PITRManagementApi.PerformPitr(ctx, cUUID, uUUID).PerformPitr(
+		req).Execute()
+	if err != nil {
+		errMessage := utils.ErrorFromHTTPResponse(response, err, utils.ResourceEntity,
+			"PITR Restore", "Create")
+		return diag.FromErr(errMessage)
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Waiting for keyspace %s to be recovered to %s",
+		p.GetDbName(), restoreTime.Format(time.RFC3339)))
+	err = utils.WaitForTask(ctx, r.GetTaskUUID(), cUUID, c, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(r.GetTaskUUID())
+	return resourcePitrRestoreRead(ctx, d, meta)
+}
+
+func resourcePitrRestoreRead(
+	ctx context.Context,
+	d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	return diag.Diagnostics{}
+}
+
+func resourcePitrRestoreDelete(
+	ctx context.Context,
+	d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	d.SetId("")
+	return nil
+}
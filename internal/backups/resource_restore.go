@@ -18,8 +18,10 @@ package backups
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
@@ -28,20 +30,24 @@ import (
 	client "github.com/yugabyte/platform-go-client"
 	"github.com/yugabyte/terraform-provider-yba/internal/api"
 	"github.com/yugabyte/terraform-provider-yba/internal/utils"
+	"golang.org/x/exp/slices"
 )
 
 // ResourceRestore to trigger Restore Operation
 func ResourceRestore() *schema.Resource {
 	return &schema.Resource{
-		Description: "Restoring backups for universe. This resource does not " +
-			"track the remote state and is only provided as a convenience tool. It " +
-			"is recommended to remove this resource after running terraform apply.",
+		Description: "Restoring backups for universe. The resource tracks the restore " +
+			"it triggered. Deleting the resource does not revert the restored data.",
 
 		CreateContext: resourceRestoreCreate,
 		ReadContext:   resourceRestoreRead,
 		UpdateContext: resourceRestoreUpdate,
 		DeleteContext: resourceRestoreDelete,
 
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(30 * time.Minute),
 			Delete: schema.DefaultTimeout(10 * time.Minute),
@@ -56,6 +62,54 @@ func ResourceRestore() *schema.Resource {
 				ForceNew:    true,
 				Description: "The UUID of the target universe of restore.",
 			},
+			"backup_uuid": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"keyspace", "storage_location"},
+				Description: "UUID of the backup to be restored. The storage locations, " +
+					"storage configuration and restore type are resolved from the backup. " +
+					"Conflicts with storage_location and keyspace.",
+			},
+			"keyspaces": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				ForceNew:     true,
+				RequiredWith: []string{"backup_uuid"},
+				Description: "Keyspaces of the backup to restore. All keyspaces of the backup " +
+					"are restored under their original names if not set.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"keyspace": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Name of the keyspace in the backup.",
+						},
+						"new_keyspace_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Description: "Name of the keyspace to restore into. Defaults to " +
+								"the name of the keyspace in the backup.",
+						},
+						"table_name_list": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Description: "Tables of the keyspace to restore. All tables of " +
+								"the keyspace are restored if not set.",
+						},
+					},
+				},
+			},
+			"restore_to_point_in_time_millis": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description: "Time, in milliseconds since epoch, to restore the backup to. " +
+					"Supported for incremental backups and backups of universes with " +
+					"point-in-time restore enabled.",
+			},
 			"keyspace": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -63,10 +117,11 @@ func ResourceRestore() *schema.Resource {
 				Description: "Target keyspace name.",
 			},
 			"storage_location": {
-				Type:        schema.TypeString,
-				Required:    true,
-				ForceNew:    true,
-				Description: "Storage Location of the backup to be restored.",
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Description: "Storage Location of the backup to be restored. Required " +
+					"when backup_uuid is not set.",
 			},
 			"sse": {
 				Type:        schema.TypeBool,
@@ -76,12 +131,14 @@ func ResourceRestore() *schema.Resource {
 			},
 			"restore_type": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
+				Computed: true,
 				ForceNew: true,
 				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(
 					[]string{"YQL_TABLE_TYPE", "REDIS_TABLE_TYPE", "PGSQL_TABLE_TYPE"}, false)),
 				Description: "Type of the restore. Permitted values: " +
-					"YQL_TABLE_TYPE, REDIS_TABLE_TYPE, PGSQL_TABLE_TYPE.",
+					"YQL_TABLE_TYPE, REDIS_TABLE_TYPE, PGSQL_TABLE_TYPE. Required when " +
+					"backup_uuid is not set.",
 			},
 
 			"parallelism": {
@@ -91,10 +148,79 @@ func ResourceRestore() *schema.Resource {
 			},
 			"storage_config_uuid": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
+				Computed: true,
 				ForceNew: true,
 				Description: "UUID of the storage configuration to use. Can be retrieved" +
-					" from the storage config data source.",
+					" from the storage config data source. Required when backup_uuid is " +
+					"not set.",
+			},
+			"state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "State of the restore.",
+			},
+			"create_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Creation time of the restore, in RFC3339 format.",
+			},
+			"update_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Last update time of the restore, in RFC3339 format.",
+			},
+			"restore_size_in_bytes": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Size of the restored data in bytes.",
+			},
+			"keyspace_status": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Status of the restore of each keyspace.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"source_keyspace": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the keyspace in the backup.",
+						},
+						"target_keyspace": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the restored keyspace.",
+						},
+						"state": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "State of the keyspace restore.",
+						},
+						"storage_location": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Storage location the keyspace was restored from.",
+						},
+						"table_name_list": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Restored tables of the keyspace.",
+						},
+						"create_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+							Description: "Start time of the keyspace restore, in RFC3339 " +
+								"format.",
+						},
+						"complete_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+							Description: "Completion time of the keyspace restore, in " +
+								"RFC3339 format.",
+						},
+					},
+				},
 			},
 		},
 	}
@@ -102,13 +228,23 @@ func ResourceRestore() *schema.Resource {
 
 func resourceRestoreDiff() schema.CustomizeDiffFunc {
 	return customdiff.All(
-		customdiff.ValidateValue("storage_location", func(ctx context.Context, value,
-			meta interface{}) error {
-			if value.(string) == "" {
+		func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+			// storage details are resolved from the backup when backup_uuid is set
+			if !d.GetRawConfig().GetAttr("backup_uuid").IsNull() {
+				return nil
+			}
+			for _, field := range []string{"storage_location", "restore_type",
+				"storage_config_uuid"} {
+				if d.GetRawConfig().GetAttr(field).IsNull() {
+					return fmt.Errorf("%s is required for restores when backup_uuid "+
+						"is not set", field)
+				}
+			}
+			if d.NewValueKnown("storage_location") && d.Get("storage_location").(string) == "" {
 				return fmt.Errorf("Cannot have empty storage location for restores")
 			}
 			return nil
-		}),
+		},
 	)
 }
 
@@ -129,41 +265,204 @@ func resourceRestoreCreate(
 			" versions) is not supported, currently on %s", utils.YBAAllowBackupMinVersion,
 			version))
 	}
-	backupStorage := client.BackupStorageInfo{
-		StorageLocation: utils.GetStringPointer(d.Get("storage_location").(string)),
-		Keyspace:        utils.GetStringPointer(d.Get("keyspace").(string)),
-		Sse:             utils.GetBoolPointer(d.Get("sse").(bool)),
-		BackupType:      utils.GetStringPointer(d.Get("restore_type").(string)),
+	uUUID := d.Get("universe_uuid").(string)
+	var backupStorageInfoList []client.BackupStorageInfo
+	storageConfigUUID := d.Get("storage_config_uuid").(string)
+	if bUUID := d.Get("backup_uuid").(string); bUUID != "" {
+		b, response, err := c.BackupsApi.GetBackupV2(ctx, cUUID, bUUID).Execute()
+		if err != nil {
+			errMessage := utils.ErrorFromHTTPResponse(response, err, utils.ResourceEntity,
+				"Restore", "Create - Fetch Backup")
+			return diag.FromErr(errMessage)
+		}
+		info := b.GetBackupInfo()
+		storageConfigUUID = b.GetStorageConfigUUID()
+		if err = d.Set("storage_config_uuid", storageConfigUUID); err != nil {
+			return diag.FromErr(err)
+		}
+		if err = d.Set("restore_type", info.GetBackupType()); err != nil {
+			return diag.FromErr(err)
+		}
+		backupStorageInfoList, err = buildBackupStorageInfoList(info,
+			d.Get("keyspaces").([]interface{}), d.Get("sse").(bool))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	} else {
+		backupStorage := client.BackupStorageInfo{
+			StorageLocation: utils.GetStringPointer(d.Get("storage_location").(string)),
+			Keyspace:        utils.GetStringPointer(d.Get("keyspace").(string)),
+			Sse:             utils.GetBoolPointer(d.Get("sse").(bool)),
+			BackupType:      utils.GetStringPointer(d.Get("restore_type").(string)),
+		}
+		backupStorageInfoList = append(backupStorageInfoList, backupStorage)
 	}
-	backupStorageInfoList := make([]client.BackupStorageInfo, 0)
-	backupStorageInfoList = append(backupStorageInfoList, backupStorage)
 
 	req := client.RestoreBackupParams{
 		ActionType:            utils.GetStringPointer("RESTORE"),
-		UniverseUUID:          d.Get("universe_uuid").(string),
-		StorageConfigUUID:     utils.GetStringPointer(d.Get("storage_config_uuid").(string)),
+		UniverseUUID:          uUUID,
+		StorageConfigUUID:     utils.GetStringPointer(storageConfigUUID),
 		Parallelism:           utils.GetInt32Pointer(int32(d.Get("parallelism").(int))),
 		CustomerUUID:          &cUUID,
 		BackupStorageInfoList: &backupStorageInfoList,
 	}
 
-	// V2 restore
-	r, response, err := c.BackupsApi.RestoreBackupV2(ctx, cUUID).Backup(req).Execute()
-	if err != nil {
-		errMessage := utils.ErrorFromHTTPResponse(response, err, utils.ResourceEntity,
-			"Restore", "Create")
-		return diag.FromErr(errMessage)
+	var r *client.YBPTask
+	if pointInTime := d.Get("restore_to_point_in_time_millis").(int); pointInTime > 0 {
+		vc := meta.(*api.APIClient).VanillaClient
+		token := meta.(*api.APIClient).APIKey
+		r, err = vc.RestoreBackupToPointInTime(ctx, cUUID, req, int64(pointInTime), token)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	} else {
+		// V2 restore
+		var response *http.Response
+		var task client.YBPTask
+		task, response, err = c.BackupsApi.RestoreBackupV2(ctx, cUUID).Backup(req).Execute()
+		if err != nil {
+			errMessage := utils.ErrorFromHTTPResponse(response, err, utils.ResourceEntity,
+				"Restore", "Create")
+			return diag.FromErr(errMessage)
+		}
+		r = &task
 	}
 
-	tflog.Debug(ctx, fmt.Sprintf("Waiting for restore %s to complete", d.Id()))
+	tflog.Debug(ctx, fmt.Sprintf("Waiting for restore task %s to complete", r.GetTaskUUID()))
 	err = utils.WaitForTask(ctx, *r.TaskUUID, cUUID, c, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	d.SetId(d.Get("keyspace").(string))
+	restore, err := findRestoreByTask(ctx, c, cUUID, uUUID, *r)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(restore.GetRestoreUUID())
 	return resourceRestoreRead(ctx, d, meta)
 }
 
+// findRestoreByTask identifies the restore triggered by the given task. Restores are
+// matched on the task response first, and then on the restore keyspace task of the newest
+// restores of the universe. Restores are not guessed from their keyspaces, since they could
+// have been started by someone else.
+func findRestoreByTask(ctx context.Context, c *client.APIClient, cUUID, uUUID string,
+	task client.YBPTask) (client.RestoreResp, error) {
+	if task.GetResourceUUID() != "" {
+		restores, err := listRestores(ctx, c, cUUID, client.RestoreApiFilter{
+			RestoreUUIDList: []string{task.GetResourceUUID()},
+		}, 1)
+		if err != nil {
+			return client.RestoreResp{}, err
+		}
+		if len(restores) > 0 {
+			return restores[0], nil
+		}
+	}
+
+	restores, err := listRestores(ctx, c, cUUID, client.RestoreApiFilter{
+		UniverseUUIDList: []string{uUUID},
+	}, 10)
+	if err != nil {
+		return client.RestoreResp{}, err
+	}
+	for _, r := range restores {
+		for _, k := range r.GetRestoreKeyspaceList() {
+			if k.GetTaskUUID() == task.GetTaskUUID() {
+				return r, nil
+			}
+		}
+	}
+	return client.RestoreResp{}, fmt.Errorf("Can't find restore triggered by task %s",
+		task.GetTaskUUID())
+}
+
+func listRestores(ctx context.Context, c *client.APIClient, cUUID string,
+	filter client.RestoreApiFilter, limit int32) ([]client.RestoreResp, error) {
+	var minTime = time.Unix(-2208988800, 0) // Jan 1, 1900
+	var maxTime = minTime.Add(1<<63 - 1)
+
+	startDate, err := time.Parse(time.RFC3339, minTime.Format(time.RFC3339))
+	if err != nil {
+		return nil, err
+	}
+	filter.DateRangeStart = &startDate
+
+	endDate, err := time.Parse(time.RFC3339, maxTime.Format(time.RFC3339))
+	if err != nil {
+		return nil, err
+	}
+	filter.DateRangeEnd = &endDate
+
+	req := client.RestorePagedApiQuery{
+		Filter:    filter,
+		SortBy:    "createTime",
+		Direction: "DESC",
+		Limit:     limit,
+	}
+	r, response, err := c.BackupsApi.ListBackupRestoresV2(ctx, cUUID).PageRestoresRequest(
+		req).Execute()
+	if err != nil {
+		errMessage := utils.ErrorFromHTTPResponse(response, err, utils.ResourceEntity,
+			"Restore", "Read")
+		return nil, errMessage
+	}
+	return r.Entities, nil
+}
+
+// buildBackupStorageInfoList selects the keyspaces of the backup to be restored, along
+// with their target names and tables
+func buildBackupStorageInfoList(info client.BackupTableParams, keyspaces []interface{},
+	sse bool) ([]client.BackupStorageInfo, error) {
+	backupList := info.GetBackupList()
+	if len(backupList) == 0 {
+		backupList = append(backupList, info)
+	}
+	res := make([]client.BackupStorageInfo, 0)
+	if len(keyspaces) == 0 {
+		for _, b := range backupList {
+			res = append(res, client.BackupStorageInfo{
+				StorageLocation: utils.GetStringPointer(b.GetStorageLocation()),
+				Keyspace:        utils.GetStringPointer(b.GetKeyspace()),
+				Sse:             utils.GetBoolPointer(sse),
+				BackupType:      utils.GetStringPointer(info.GetBackupType()),
+			})
+		}
+		return res, nil
+	}
+	for _, k := range keyspaces {
+		keyspace := k.(map[string]interface{})
+		name := keyspace["keyspace"].(string)
+		var source *client.BackupTableParams
+		for i := range backupList {
+			if backupList[i].GetKeyspace() == name {
+				source = &backupList[i]
+				break
+			}
+		}
+		if source == nil {
+			return nil, fmt.Errorf("Keyspace %s is not part of backup %s", name,
+				info.GetBackupUuid())
+		}
+		target := keyspace["new_keyspace_name"].(string)
+		if target == "" {
+			target = name
+		}
+		r := client.BackupStorageInfo{
+			StorageLocation: utils.GetStringPointer(source.GetStorageLocation()),
+			Keyspace:        utils.GetStringPointer(target),
+			Sse:             utils.GetBoolPointer(sse),
+			BackupType:      utils.GetStringPointer(info.GetBackupType()),
+		}
+		tables := keyspace["table_name_list"].([]interface{})
+		if len(tables) > 0 {
+			r.TableNameList = utils.StringSlice(tables)
+			r.SelectiveTableRestore = utils.GetBoolPointer(true)
+		}
+		res = append(res, r)
+	}
+	return res, nil
+}
+
 func resourceRestoreRead(
 	ctx context.Context,
 	d *schema.ResourceData,
@@ -184,62 +483,231 @@ func resourceRestoreRead(
 			utils.YBAAllowBackupMinVersion, version))
 	}
 
-	req := client.RestorePagedApiQuery{
-		Filter: client.RestoreApiFilter{
-			States: *utils.StringSlice(utils.CreateSingletonList("Completed")),
-			UniverseUUIDList: *utils.StringSlice(utils.CreateSingletonList(
-				d.Get("universe_uuid"))),
-		},
-		SortBy:    "createTime",
-		Direction: "DESC",
-		Limit:     *utils.GetInt32Pointer(10),
+	// restores created by earlier versions of the provider are identified by the
+	// target keyspace, and are resolved to the completed restore of the keyspace
+	if _, err = uuid.Parse(d.Id()); err != nil {
+		restore, err := findLegacyRestore(ctx, c, cUUID, d.Get("universe_uuid").(string),
+			d.Id(), d.Get("storage_location").(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		d.SetId(restore.GetRestoreUUID())
 	}
-	var minTime = time.Unix(-2208988800, 0) // Jan 1, 1900
-	var maxTime = minTime.Add(1<<63 - 1)
 
-	startDate, err := time.Parse(time.RFC3339, minTime.Format(time.RFC3339))
+	restores, err := listRestores(ctx, c, cUUID, client.RestoreApiFilter{
+		RestoreUUIDList: []string{d.Id()},
+	}, 1)
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	req.Filter.DateRangeStart = &startDate
+	if len(restores) == 0 {
+		return diag.Errorf("Can't find restore %s", d.Id())
+	}
+	restore := restores[0]
 
-	endDate, err := time.Parse(time.RFC3339, maxTime.Format(time.RFC3339))
-	if err != nil {
+	keyspaceList := restore.GetRestoreKeyspaceList()
+	// imported restores have neither backup_uuid nor storage_location in the state, the
+	// inputs of the restore are resolved from the restore and the backup it restored
+	if d.Get("backup_uuid").(string) == "" && d.Get("storage_location").(string) == "" {
+		if err = setImportedRestoreInputs(ctx, c, cUUID, d, restore); err != nil {
+			return diag.FromErr(err)
+		}
+	} else if len(keyspaceList) > 0 && d.Get("backup_uuid").(string) == "" {
+		keyspace := keyspaceList[0]
+		if err = d.Set("storage_location", keyspace.GetStorageLocation()); err != nil {
+			return diag.FromErr(err)
+		}
+		if err = d.Set("keyspace", keyspace.GetTargetKeyspace()); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if err = d.Set("universe_uuid", restore.GetUniverseUUID()); err != nil {
+		return diag.FromErr(err)
+	}
+	if restore.GetBackupType() != "" {
+		if err = d.Set("restore_type", restore.GetBackupType()); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if err = d.Set("state", restore.GetState()); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("create_time", formatBackupTime(restore.CreateTime)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("update_time", formatBackupTime(restore.UpdateTime)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("restore_size_in_bytes", restore.GetRestoreSizeInBytes()); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("keyspace_status", flattenRestoreKeyspaces(keyspaceList)); err != nil {
 		return diag.FromErr(err)
 	}
-	req.Filter.DateRangeEnd = &endDate
+	return diags
+}
 
-	r, response, err := c.BackupsApi.ListBackupRestoresV2(ctx, cUUID).PageRestoresRequest(
-		req).Execute()
+// findLegacyRestore returns the completed restore of the universe into the keyspace. The
+// storage location, when known, narrows down restores of the same keyspace from different
+// backups. More than one match is reported as an error instead of guessing.
+func findLegacyRestore(ctx context.Context, c *client.APIClient, cUUID, uUUID, keyspace,
+	storageLocation string) (client.RestoreResp, error) {
+	restores, err := listRestores(ctx, c, cUUID, client.RestoreApiFilter{
+		States:           []string{"Completed"},
+		UniverseUUIDList: []string{uUUID},
+	}, 100)
 	if err != nil {
-		errMessage := utils.ErrorFromHTTPResponse(response, err, utils.ResourceEntity,
-			"Restore", "Read")
-		return diag.FromErr(errMessage)
+		return client.RestoreResp{}, err
 	}
+	matches := make([]client.RestoreResp, 0)
+	for _, r := range restores {
+		keyspaces := r.GetRestoreKeyspaceList()
+		if len(keyspaces) != 1 || keyspaces[0].GetTargetKeyspace() != keyspace {
+			continue
+		}
+		if storageLocation != "" && keyspaces[0].GetStorageLocation() != storageLocation {
+			continue
+		}
+		matches = append(matches, r)
+	}
+	if len(matches) == 0 {
+		return client.RestoreResp{}, fmt.Errorf("Can't find restore of keyspace %s", keyspace)
+	}
+	if len(matches) > 1 {
+		uuids := make([]string, 0)
+		for _, r := range matches {
+			uuids = append(uuids, r.GetRestoreUUID())
+		}
+		return client.RestoreResp{}, fmt.Errorf("Found %d restores of keyspace %s: %v, "+
+			"remove the resource from the state and import the restore by its UUID",
+			len(matches), keyspace, uuids)
+	}
+	return matches[0], nil
+}
 
-	if len(r.Entities) > 0 {
-		chosenRestore := r.Entities[0]
-		keyspaceList := chosenRestore.GetRestoreKeyspaceList()
-		if len(keyspaceList) > 0 {
-			keyspace := keyspaceList[0]
-			if err = d.Set("storage_location", keyspace.GetStorageLocation()); err != nil {
-				return diag.FromErr(err)
-			}
-			if err = d.Set("keyspace", keyspace.GetTargetKeyspace()); err != nil {
-				return diag.FromErr(err)
-			}
-
+// setImportedRestoreInputs sets the inputs of an imported restore so that it does not plan
+// a replacement. Restores of a backup that is still present are set up with backup_uuid and
+// keyspaces, other restores of a single keyspace with storage_location and keyspace.
+func setImportedRestoreInputs(ctx context.Context, c *client.APIClient, cUUID string,
+	d *schema.ResourceData, restore client.RestoreResp) error {
+	keyspaceList := restore.GetRestoreKeyspaceList()
+	backup, err := findRestoredBackup(ctx, c, cUUID, restore)
+	if err != nil {
+		return err
+	}
+	if backup == nil {
+		if len(keyspaceList) != 1 {
+			return fmt.Errorf("Can't find the backup restored by restore %s",
+				restore.GetRestoreUUID())
 		}
-		if err = d.Set("universe_uuid", chosenRestore.UniverseUUID); err != nil {
-			return diag.FromErr(err)
+		if err = d.Set("storage_location", keyspaceList[0].GetStorageLocation()); err != nil {
+			return err
 		}
+		return d.Set("keyspace", keyspaceList[0].GetTargetKeyspace())
+	}
 
-		return diags
+	info := backup.GetCommonBackupInfo()
+	if err = d.Set("backup_uuid", info.GetBackupUUID()); err != nil {
+		return err
+	}
+	if err = d.Set("storage_config_uuid", info.GetStorageConfigUUID()); err != nil {
+		return err
 	}
+	// keyspaces is left empty for restores of all the keyspaces of the backup under their
+	// original names, which is the default of the resource
+	keyspaces := make([]map[string]interface{}, 0)
+	renamedOrSelective := len(keyspaceList) != len(info.GetResponseList())
+	for _, k := range keyspaceList {
+		keyspace := map[string]interface{}{
+			"keyspace":          k.GetSourceKeyspace(),
+			"new_keyspace_name": "",
+			"table_name_list":   k.GetTableNameList(),
+		}
+		if k.GetTargetKeyspace() != k.GetSourceKeyspace() {
+			keyspace["new_keyspace_name"] = k.GetTargetKeyspace()
+			renamedOrSelective = true
+		}
+		if len(k.GetTableNameList()) > 0 {
+			renamedOrSelective = true
+		}
+		keyspaces = append(keyspaces, keyspace)
+	}
+	if !renamedOrSelective {
+		keyspaces = make([]map[string]interface{}, 0)
+	}
+	return d.Set("keyspaces", keyspaces)
+}
 
-	d.SetId(d.Get("keyspace").(string))
-	return diags
+// findRestoredBackup returns the backup of the source universe whose storage locations
+// include the storage locations of the restore, nil if the backup no longer exists
+func findRestoredBackup(ctx context.Context, c *client.APIClient, cUUID string,
+	restore client.RestoreResp) (*client.BackupResp, error) {
+	var minTime = time.Unix(-2208988800, 0) // Jan 1, 1900
+	var maxTime = minTime.Add(1<<63 - 1)
 
+	startDate, err := time.Parse(time.RFC3339, minTime.Format(time.RFC3339))
+	if err != nil {
+		return nil, err
+	}
+	endDate, err := time.Parse(time.RFC3339, maxTime.Format(time.RFC3339))
+	if err != nil {
+		return nil, err
+	}
+	keyspaces := make([]string, 0)
+	locations := make([]string, 0)
+	for _, k := range restore.GetRestoreKeyspaceList() {
+		keyspaces = append(keyspaces, k.GetSourceKeyspace())
+		locations = append(locations, k.GetStorageLocation())
+	}
+	req := client.BackupPagedApiQuery{
+		Filter: client.BackupApiFilter{
+			DateRangeStart:   &startDate,
+			DateRangeEnd:     &endDate,
+			KeyspaceList:     keyspaces,
+			UniverseUUIDList: []string{restore.GetSourceUniverseUUID()},
+		},
+		SortBy:    "createTime",
+		Direction: "DESC",
+		Limit:     100,
+	}
+	backups, err := listBackups(ctx, c, cUUID, req, utils.ResourceEntity)
+	if err != nil {
+		return nil, err
+	}
+	for i, b := range backups {
+		backupLocations := make([]string, 0)
+		info := b.GetCommonBackupInfo()
+		for _, k := range info.GetResponseList() {
+			backupLocations = append(backupLocations, k.GetDefaultLocation())
+		}
+		matched := len(locations) > 0
+		for _, l := range locations {
+			if !slices.Contains(backupLocations, l) {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			return &backups[i], nil
+		}
+	}
+	return nil, nil
+}
+
+func flattenRestoreKeyspaces(keyspaces []client.RestoreKeyspace) []map[string]interface{} {
+	res := make([]map[string]interface{}, 0)
+	for _, k := range keyspaces {
+		res = append(res, map[string]interface{}{
+			"source_keyspace":  k.GetSourceKeyspace(),
+			"target_keyspace":  k.GetTargetKeyspace(),
+			"state":            k.GetState(),
+			"storage_location": k.GetStorageLocation(),
+			"table_name_list":  k.GetTableNameList(),
+			"create_time":      formatBackupTime(k.CreateTime),
+			"complete_time":    formatBackupTime(k.CompleteTime),
+		})
+	}
+	return res
 }
 
 func resourceRestoreUpdate(
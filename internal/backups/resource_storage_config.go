@@ -19,6 +19,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net/http"
 	"os"
 	"strconv"
 	"strings"
@@ -80,27 +81,10 @@ func ResourceStorageConfig() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"sas_token": {
-							Type:      schema.TypeString,
-							Required:  true,
-							Sensitive: true,
-							DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
-								if len(old) > 0 && utils.ObfuscateString(new, 2) == old {
-									azCredentialsInterface := d.Get("azure_credentials").([]interface{})
-									if len(azCredentialsInterface) > 0 &&
-										azCredentialsInterface[0] != nil {
-										azCredentials := utils.MapFromSingletonList(
-											azCredentialsInterface)
-										azCredentials["sas_token"] = new
-										azCredentialsList := []map[string]interface{}{
-											azCredentials,
-										}
-										d.Set("azure_credentials", azCredentialsList)
-
-									}
-									return true
-								}
-								return false
-							},
+							Type:             schema.TypeString,
+							Required:         true,
+							Sensitive:        true,
+							DiffSuppressFunc: suppressObfuscatedCredentialDiff,
 							Description: "Azure SAS Token. Can also be set using " +
 								"environment variable AZURE_STORAGE_SAS_TOKEN.",
 						},
@@ -114,29 +98,10 @@ func ResourceStorageConfig() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"application_credentials": {
-							Type:      schema.TypeString,
-							Required:  true,
-							Sensitive: true,
-							DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
-								new = strings.ReplaceAll(new, "\n", "")
-								old = strings.ReplaceAll(old, "\n", "")
-								if len(old) > 0 && utils.ObfuscateString(new, 2) == old {
-									gcsCredentialsInterface := d.Get("gcs_credentials").([]interface{})
-									if len(gcsCredentialsInterface) > 0 &&
-										gcsCredentialsInterface[0] != nil {
-										gcsCredentials := utils.MapFromSingletonList(
-											gcsCredentialsInterface)
-										gcsCredentials["application_credentials"] = new
-										gcsCredentialsList := []map[string]interface{}{
-											gcsCredentials,
-										}
-										d.Set("gcs_credentials", gcsCredentialsList)
-
-									}
-									return true
-								}
-								return false
-							},
+							Type:             schema.TypeString,
+							Required:         true,
+							Sensitive:        true,
+							DiffSuppressFunc: suppressObfuscatedCredentialDiff,
 							Description: "Google Service Account JSON Credentials as string. " +
 								"Can also be set by providing the JSON file path with the " +
 								"environment variable GOOGLE_APPLICATION_CREDENTIALS.",
@@ -150,85 +115,215 @@ func ResourceStorageConfig() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"access_key_id": {
-							Type:      schema.TypeString,
-							Required:  true,
-							Sensitive: true,
-							DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
-								if len(old) > 0 && utils.ObfuscateString(new, 2) == old {
-									s3CredentialsInterface := d.Get("s3_credentials").([]interface{})
-									if len(s3CredentialsInterface) > 0 &&
-										s3CredentialsInterface[0] != nil {
-										s3Credentials := utils.MapFromSingletonList(
-											s3CredentialsInterface)
-										s3Credentials["access_key_id"] = new
-										s3CredentialsList := []map[string]interface{}{
-											s3Credentials,
-										}
-										d.Set("s3_credentials", s3CredentialsList)
-
-									}
-									return true
-								}
-								return false
-							},
+							Type:             schema.TypeString,
+							Required:         true,
+							Sensitive:        true,
+							DiffSuppressFunc: suppressObfuscatedCredentialDiff,
 							Description: "S3 Access Key ID. Can also be set using " +
 								"environment variable AWS_ACCESS_KEY_ID.",
 						},
 						"secret_access_key": {
-							Type:      schema.TypeString,
-							Required:  true,
-							Sensitive: true,
-							DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
-								if len(old) > 0 && utils.ObfuscateString(new, 2) == old {
-									s3CredentialsInterface := d.Get("s3_credentials").([]interface{})
-									if len(s3CredentialsInterface) > 0 &&
-										s3CredentialsInterface[0] != nil {
-										s3Credentials := utils.MapFromSingletonList(
-											s3CredentialsInterface)
-										s3Credentials["secret_access_key"] = new
-										s3CredentialsList := []map[string]interface{}{
-											s3Credentials,
-										}
-										d.Set("s3_credentials", s3CredentialsList)
-
-									}
-									return true
-								}
-								return false
-							},
+							Type:             schema.TypeString,
+							Required:         true,
+							Sensitive:        true,
+							DiffSuppressFunc: suppressObfuscatedCredentialDiff,
 							Description: "S3 Secret Access Key. Can also be set using " +
 								"environment variable AWS_SECRET_ACCESS_KEY.",
 						},
 					}},
 				Description: "Credentials for S3 storage configurations.",
 			},
+			"credentials_version": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Description: "Version of the credentials. Changing the value resends the " +
+					"credentials from the configuration or environment variables to " +
+					"YugabyteDB Anywhere, which rotates them without changes to the " +
+					"credential fields. Updates of other fields keep the credentials stored " +
+					"in YugabyteDB Anywhere.",
+			},
+			"aws_credential_source": utils.AwsCredentialSourceSchema(),
+			"endpoint": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: "Endpoint of an S3 compatible object store, such as MinIO or " +
+					"Ceph, for S3 storage configurations. Defaults to AWS S3.",
+			},
+			"path_style_access": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				Description: "Use path-style addressing for S3 storage configurations, " +
+					"required by most S3 compatible object stores. False by default.",
+			},
+			"ca_certificate": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: "PEM encoded CA certificate of the S3 compatible endpoint. " +
+					"The certificate is added to the custom CA trust store of YugabyteDB " +
+					"Anywhere, which must be enabled with the runtime configuration " +
+					"yb.customCATrustStore.enabled.",
+			},
+			"ca_certificate_uuid": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "UUID of the CA certificate in the custom CA trust store.",
+			},
 			"data": {
 				Type:        schema.TypeMap,
 				Computed:    true,
 				Description: "Location and Credentials.",
 			},
 			"backup_location": {
-				Type:        schema.TypeString,
-				Required:    true,
-				ForceNew:    true,
-				Description: "The complete backup location including \"s3://\" or \"gs://\".",
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				Description: "The complete backup location including \"s3://\" or \"gs://\". " +
+					"For NFS storage configurations, the absolute path of the NFS mount on " +
+					"the database nodes.",
 			},
 			"config_name": {
 				Type:        schema.TypeString,
 				Required:    true,
 				Description: "Name of the Storage Configuration.",
 			},
+			"region_location": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Description: "Per-region backup locations. Backups of geo-partitioned " +
+					"universes store the data of each region in its region location, and " +
+					"fall back to backup_location for other regions.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"region": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Region code, such as us-west-2.",
+						},
+						"location": {
+							Type:     schema.TypeString,
+							Required: true,
+							Description: "The complete backup location of the region including " +
+								"\"s3://\" or \"gs://\".",
+						},
+						"aws_host_base": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Description: "Host base of the S3 endpoint of the region. Only " +
+								"applicable to S3 storage configurations.",
+						},
+					},
+				},
+			},
 		},
 	}
 }
 
 func resourceStorageConfigDiff() schema.CustomizeDiffFunc {
 	return customdiff.All(
+		func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+			// YugabyteDB Anywhere rejects deleting storage configs that are in use, which
+			// replacing the storage config requires
+			if d.Id() == "" || !(d.HasChange("name") || d.HasChange("backup_location") ||
+				d.HasChange("region_location")) {
+				return nil
+			}
+			c := meta.(*api.APIClient).YugawareClient
+			cUUID := meta.(*api.APIClient).CustomerID
+			r, response, err := c.CustomerConfigurationApi.GetListOfCustomerConfig(ctx,
+				cUUID).Execute()
+			if err != nil {
+				return utils.ErrorFromHTTPResponse(response, err, utils.ResourceEntity,
+					"Storage Config", "Validate")
+			}
+			config, err := findCustomerConfig(r, d.Id())
+			if err != nil {
+				// the storage config was deleted outside of terraform
+				return nil
+			}
+			if config.GetInUse() {
+				universes := make([]string, 0)
+				for _, u := range config.GetUniverseDetails() {
+					universes = append(universes, u.GetName())
+				}
+				return fmt.Errorf("Storage config %s is in use by universes [%s] and cannot "+
+					"be replaced to change name, backup_location or region_location",
+					config.ConfigName, strings.Join(universes, ", "))
+			}
+			return nil
+		},
+		customdiff.IfValue("name",
+			func(ctx context.Context, value, meta interface{}) bool {
+				return value.(string) != "S3"
+			},
+			func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+				name := d.Get("name").(string)
+				if d.Get("endpoint").(string) != "" {
+					return fmt.Errorf("Cannot set endpoint for %s storage configuration", name)
+				}
+				if d.Get("path_style_access").(bool) {
+					return fmt.Errorf("Cannot set path_style_access for %s storage "+
+						"configuration", name)
+				}
+				if d.Get("ca_certificate").(string) != "" {
+					return fmt.Errorf("Cannot set ca_certificate for %s storage "+
+						"configuration", name)
+				}
+				if len(d.Get("aws_credential_source").([]interface{})) > 0 {
+					return fmt.Errorf("Cannot set aws_credential_source for %s storage "+
+						"configuration", name)
+				}
+				for _, r := range d.Get("region_location").([]interface{}) {
+					if r == nil {
+						continue
+					}
+					if r.(map[string]interface{})["aws_host_base"].(string) != "" {
+						return fmt.Errorf("Cannot set aws_host_base in region_location for "+
+							"%s storage configuration", name)
+					}
+				}
+				return nil
+			}),
+		customdiff.IfValue("name",
+			func(ctx context.Context, value, meta interface{}) bool {
+				return value.(string) == "NFS"
+			},
+			func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+				for _, credentials := range []string{"s3_credentials", "gcs_credentials",
+					"azure_credentials"} {
+					if len(d.Get(credentials).([]interface{})) > 0 {
+						return fmt.Errorf("Cannot set %s for NFS storage configuration",
+							credentials)
+					}
+				}
+				if d.NewValueKnown("backup_location") &&
+					!strings.HasPrefix(d.Get("backup_location").(string), "/") {
+					return fmt.Errorf("NFS backup location must be an absolute path")
+				}
+				return nil
+			}),
+		customdiff.IfValue("endpoint",
+			func(ctx context.Context, value, meta interface{}) bool {
+				return value.(string) != ""
+			},
+			func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+				endpoint := d.Get("endpoint").(string)
+				if strings.Contains(endpoint, "/") && !strings.HasPrefix(endpoint, "http://") &&
+					!strings.HasPrefix(endpoint, "https://") {
+					return fmt.Errorf("S3 endpoint %s must be a host name or a URL "+
+						"starting with http:// or https://", endpoint)
+				}
+				return nil
+			}),
 		customdiff.IfValue("name",
 			func(ctx context.Context, value, meta interface{}) bool {
 				return value.(string) == "GCS"
 			},
 			func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+				if !storageConfigSendsCredentials(d) {
+					return nil
+				}
 				errorMessage := "Empty env variable: "
 
 				gcsCredentialsInterface := d.Get("gcs_credentials").([]interface{})
@@ -253,6 +348,9 @@ func resourceStorageConfigDiff() schema.CustomizeDiffFunc {
 				return value.(string) == "AZ"
 			},
 			func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+				if !storageConfigSendsCredentials(d) {
+					return nil
+				}
 				errorMessage := "Empty env variable: "
 
 				azCredentialsInterface := d.Get("azure_credentials").([]interface{})
@@ -303,6 +401,9 @@ func resourceStorageConfigDiff() schema.CustomizeDiffFunc {
 				return !value.(bool)
 			},
 			func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+				if !storageConfigSendsCredentials(d) {
+					return nil
+				}
 				var errorString string
 				errorMessage := "Empty env variable: "
 
@@ -312,6 +413,10 @@ func resourceStorageConfigDiff() schema.CustomizeDiffFunc {
 					s3CredentialsInterface := d.Get("s3_credentials").([]interface{})
 					if len(s3CredentialsInterface) == 0 ||
 						(len(s3CredentialsInterface) > 0 && s3CredentialsInterface[0] == nil) {
+						// credentials are resolved from aws_credential_source instead of env
+						if len(d.Get("aws_credential_source").([]interface{})) > 0 {
+							return nil
+						}
 						_, isPresentAccessKeyID := os.LookupEnv(utils.AWSAccessKeyEnv)
 						if !isPresentAccessKeyID {
 							errorString = fmt.Sprintf("%s%s ", errorString, utils.AWSAccessKeyEnv)
@@ -343,11 +448,86 @@ func resourceStorageConfigDiff() schema.CustomizeDiffFunc {
 	)
 }
 
-func buildData(ctx context.Context, d *schema.ResourceData) (map[string]interface{}, error) {
+// storageConfigCredentialFields lists the fields whose change requires the credentials
+// to be sent to YugabyteDB Anywhere
+func storageConfigCredentialFields() []string {
+	return []string{"s3_credentials", "gcs_credentials", "azure_credentials",
+		"aws_credential_source", "use_iam_instance_profile", "credentials_version"}
+}
+
+// storageConfigSendsCredentials checks if credentials are sent to YugabyteDB Anywhere, which
+// happens on creation and on changes of the credential fields
+func storageConfigSendsCredentials(d *schema.ResourceDiff) bool {
+	if d.Id() == "" {
+		return true
+	}
+	for _, field := range storageConfigCredentialFields() {
+		if d.HasChange(field) {
+			return true
+		}
+	}
+	return false
+}
+
+// suppressObfuscatedCredentialDiff ignores the difference between a configured credential
+// and its obfuscated value returned by YugabyteDB Anywhere
+func suppressObfuscatedCredentialDiff(k, old, new string, d *schema.ResourceData) bool {
+	new = strings.ReplaceAll(new, "\n", "")
+	old = strings.ReplaceAll(old, "\n", "")
+	return len(old) > 0 && (old == new || utils.ObfuscateString(new, 2) == old)
+}
+
+// configuredCredential reads a credential from the configuration, since the planned value
+// can hold the obfuscated value when the difference is suppressed
+func configuredCredential(d *schema.ResourceData, block, field string) string {
+	raw := d.GetRawConfig().GetAttr(block)
+	if !raw.IsKnown() || raw.IsNull() || raw.LengthInt() == 0 {
+		return ""
+	}
+	value := raw.AsValueSlice()[0].GetAttr(field)
+	if !value.IsKnown() || value.IsNull() {
+		return ""
+	}
+	return value.AsString()
+}
+
+// storedCredential keeps the credential in the state if YugabyteDB Anywhere stores the same
+// value, and returns the obfuscated value otherwise to surface the difference
+func storedCredential(configured string, stored interface{}) string {
+	storedString, _ := stored.(string)
+	if configured != "" && utils.ObfuscateString(configured, 2) == storedString {
+		return configured
+	}
+	return storedString
+}
+
+func buildData(ctx context.Context, d *schema.ResourceData, withCredentials bool) (
+	map[string]interface{}, error) {
 	data := map[string]interface{}{
 		"BACKUP_LOCATION": d.Get("backup_location").(string),
 	}
 
+	regionLocations := buildRegionLocations(d.Get("region_location").([]interface{}))
+	if len(regionLocations) > 0 {
+		data["REGION_LOCATIONS"] = regionLocations
+	}
+
+	if d.Get("name").(string) == "S3" {
+		if endpoint := d.Get("endpoint").(string); endpoint != "" {
+			data["AWS_HOST_BASE"] = endpoint
+		}
+		if d.Get("path_style_access").(bool) {
+			data["PATH_STYLE_ACCESS"] = strconv.FormatBool(true)
+		}
+		if d.Get("use_iam_instance_profile").(bool) {
+			data["IAM_INSTANCE_PROFILE"] = strconv.FormatBool(true)
+		}
+	}
+
+	if !withCredentials {
+		return data, nil
+	}
+
 	if d.Get("name").(string) == "GCS" {
 		var gcsCredString string
 		var err error
@@ -360,39 +540,37 @@ func buildData(ctx context.Context, d *schema.ResourceData) (map[string]interfac
 			}
 
 		} else {
-			gcsCredentials := utils.MapFromSingletonList(gcsCredentialsInterface)
-			applicationCreds := gcsCredentials["application_credentials"]
-			if applicationCreds != nil && len(applicationCreds.(string)) > 0 {
-				gcsCredString = strings.ReplaceAll(applicationCreds.(string), "\n", "")
+			applicationCreds := configuredCredential(d, "gcs_credentials",
+				"application_credentials")
+			if len(applicationCreds) > 0 {
+				gcsCredString = strings.ReplaceAll(applicationCreds, "\n", "")
 			}
 		}
 		data[utils.GCSCredentialsJSON] = gcsCredString
 	}
 
-	if d.Get("name").(string) == "S3" {
+	if d.Get("name").(string) == "S3" && !d.Get("use_iam_instance_profile").(bool) {
 		s3CredentialsInterface := d.Get("s3_credentials").([]interface{})
-		isIAM := d.Get("use_iam_instance_profile").(bool)
-		if isIAM {
-			data["IAM_INSTANCE_PROFILE"] = strconv.FormatBool(isIAM)
+		if len(s3CredentialsInterface) == 0 ||
+			(len(s3CredentialsInterface) > 0 && s3CredentialsInterface[0] == nil) {
+			awsCreds, err := utils.AwsCredentials(utils.AwsCredentialSourceFromList(
+				d.Get("aws_credential_source").([]interface{})))
+			if err != nil {
+				return nil, err
+			}
+			data[utils.AWSAccessKeyEnv] = awsCreds.AccessKeyID
+			data[utils.AWSSecretAccessKeyEnv] = awsCreds.SecretAccessKey
+			if awsCreds.SessionToken != "" {
+				data[utils.AWSSessionTokenEnv] = awsCreds.SessionToken
+			}
 		} else {
-			if len(s3CredentialsInterface) == 0 ||
-				(len(s3CredentialsInterface) > 0 && s3CredentialsInterface[0] == nil) {
-				awsCreds, err := utils.AwsCredentialsFromEnv()
-				if err != nil {
-					return nil, err
-				}
-				data[utils.AWSAccessKeyEnv] = awsCreds.AccessKeyID
-				data[utils.AWSSecretAccessKeyEnv] = awsCreds.SecretAccessKey
-			} else {
-				s3Credentials := utils.MapFromSingletonList(s3CredentialsInterface)
-				accessKeyID := s3Credentials["access_key_id"]
-				secretAccessKey := s3Credentials["secret_access_key"]
-				if accessKeyID != nil && len(accessKeyID.(string)) > 0 {
-					data[utils.AWSAccessKeyEnv] = accessKeyID.(string)
-				}
-				if secretAccessKey != nil && len(secretAccessKey.(string)) > 0 {
-					data[utils.AWSSecretAccessKeyEnv] = secretAccessKey.(string)
-				}
+			accessKeyID := configuredCredential(d, "s3_credentials", "access_key_id")
+			secretAccessKey := configuredCredential(d, "s3_credentials", "secret_access_key")
+			if len(accessKeyID) > 0 {
+				data[utils.AWSAccessKeyEnv] = accessKeyID
+			}
+			if len(secretAccessKey) > 0 {
+				data[utils.AWSSecretAccessKeyEnv] = secretAccessKey
 			}
 		}
 	}
@@ -406,10 +584,9 @@ func buildData(ctx context.Context, d *schema.ResourceData) (map[string]interfac
 			}
 			data[utils.AzureStorageSasTokenEnv] = azureCreds
 		} else {
-			azCredentials := utils.MapFromSingletonList(azCredentialsInterface)
-			sasToken := azCredentials["sas_token"]
-			if sasToken != nil && len(sasToken.(string)) > 0 {
-				data[utils.AzureStorageSasTokenEnv] = sasToken.(string)
+			sasToken := configuredCredential(d, "azure_credentials", "sas_token")
+			if len(sasToken) > 0 {
+				data[utils.AzureStorageSasTokenEnv] = sasToken
 			}
 		}
 
@@ -417,6 +594,48 @@ func buildData(ctx context.Context, d *schema.ResourceData) (map[string]interfac
 	return data, nil
 }
 
+func buildRegionLocations(regionLocations []interface{}) []map[string]interface{} {
+	res := make([]map[string]interface{}, 0)
+	for _, r := range regionLocations {
+		if r == nil {
+			continue
+		}
+		regionLocation := r.(map[string]interface{})
+		location := map[string]interface{}{
+			"REGION":   regionLocation["region"].(string),
+			"LOCATION": regionLocation["location"].(string),
+		}
+		if hostBase := regionLocation["aws_host_base"].(string); hostBase != "" {
+			location["AWS_HOST_BASE"] = hostBase
+		}
+		res = append(res, location)
+	}
+	return res
+}
+
+func flattenRegionLocations(regionLocations interface{}) []map[string]interface{} {
+	res := make([]map[string]interface{}, 0)
+	locations, ok := regionLocations.([]interface{})
+	if !ok {
+		return res
+	}
+	for _, r := range locations {
+		regionLocation, ok := r.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		region, _ := regionLocation["REGION"].(string)
+		location, _ := regionLocation["LOCATION"].(string)
+		hostBase, _ := regionLocation["AWS_HOST_BASE"].(string)
+		res = append(res, map[string]interface{}{
+			"region":        region,
+			"location":      location,
+			"aws_host_base": hostBase,
+		})
+	}
+	return res
+}
+
 func resourceStorageConfigCreate(
 	ctx context.Context,
 	d *schema.ResourceData,
@@ -425,10 +644,29 @@ func resourceStorageConfigCreate(
 	cUUID := meta.(*api.APIClient).CustomerID
 
 	// type, name, config name, data [backup__location and credentials]
-	data, err := buildData(ctx, d)
+	data, err := buildData(ctx, d, true)
 	if err != nil {
 		return diag.FromErr(err)
 	}
+
+	certUUID := ""
+	if d.Get("ca_certificate").(string) != "" {
+		var response *http.Response
+		certUUID, response, err = c.CustomCACertificatesApi.AddCA(ctx, cUUID).X509CACertificate(
+			client.CustomCACertParams{
+				Name:     d.Get("config_name").(string),
+				Contents: d.Get("ca_certificate").(string),
+			}).Execute()
+		if err != nil {
+			errMessage := utils.ErrorFromHTTPResponse(response, err, utils.ResourceEntity,
+				"Storage Config", "Create - Add CA Certificate")
+			return diag.FromErr(errMessage)
+		}
+		if err = d.Set("ca_certificate_uuid", certUUID); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	req := client.CustomerConfig{
 		ConfigName:   d.Get("config_name").(string),
 		CustomerUUID: cUUID,
@@ -441,7 +679,18 @@ func resourceStorageConfigCreate(
 	if err != nil {
 		errMessage := utils.ErrorFromHTTPResponse(response, err, utils.ResourceEntity,
 			"Storage Config", "Create")
-		return diag.FromErr(errMessage)
+		diags := diag.FromErr(errMessage)
+		if certUUID != "" {
+			// the certificate is not left behind without a storage config using it
+			_, response, err = c.CustomCACertificatesApi.DeleteCustomCACertificate(ctx, cUUID,
+				certUUID).Execute()
+			if err != nil {
+				errMessage = utils.ErrorFromHTTPResponse(response, err, utils.ResourceEntity,
+					"Storage Config", "Create - Delete CA Certificate")
+				diags = append(diags, diag.FromErr(errMessage)...)
+			}
+		}
+		return diags
 	}
 
 	d.SetId(*r.ConfigUUID)
@@ -471,24 +720,41 @@ func resourceStorageConfigRead(
 	if err = d.Set("config_name", config.ConfigName); err != nil {
 		return diag.FromErr(err)
 	}
-	if err = d.Set("data", config.Data); err != nil {
+	// region locations are not flat values and are tracked in region_location instead
+	data := make(map[string]interface{})
+	for k, v := range config.GetData() {
+		if k != "REGION_LOCATIONS" {
+			data[k] = v
+		}
+	}
+	if err = d.Set("data", data); err != nil {
+		return diag.FromErr(err)
+	}
+	err = d.Set("region_location", flattenRegionLocations(config.GetData()["REGION_LOCATIONS"]))
+	if err != nil {
 		return diag.FromErr(err)
 	}
 	if err = d.Set("name", config.Name); err != nil {
 		return diag.FromErr(err)
 	}
 	if config.GetName() == "S3" {
+		endpoint, _ := config.GetData()["AWS_HOST_BASE"].(string)
+		if err = d.Set("endpoint", endpoint); err != nil {
+			return diag.FromErr(err)
+		}
+		pathStyleAccess, _ := config.GetData()["PATH_STYLE_ACCESS"].(string)
+		if err = d.Set("path_style_access", pathStyleAccess == "true"); err != nil {
+			return diag.FromErr(err)
+		}
 		s3CredentialsInterface := d.Get("s3_credentials").([]interface{})
 		if len(s3CredentialsInterface) > 0 && s3CredentialsInterface[0] != nil {
 			s3Credentials := utils.MapFromSingletonList(s3CredentialsInterface)
-			accessKeyID := s3Credentials["access_key_id"]
-			secretAccessKey := s3Credentials["secret_access_key"]
-			if accessKeyID != nil && len(accessKeyID.(string)) > 0 {
-				s3Credentials["access_key_id"] = config.GetData()[utils.AWSAccessKeyEnv]
-			}
-			if secretAccessKey != nil && len(secretAccessKey.(string)) > 0 {
-				s3Credentials["secret_access_key"] = config.GetData()[utils.AWSSecretAccessKeyEnv]
-			}
+			s3Credentials["access_key_id"] = storedCredential(
+				s3Credentials["access_key_id"].(string),
+				config.GetData()[utils.AWSAccessKeyEnv])
+			s3Credentials["secret_access_key"] = storedCredential(
+				s3Credentials["secret_access_key"].(string),
+				config.GetData()[utils.AWSSecretAccessKeyEnv])
 			s3CredentialsList := []map[string]interface{}{s3Credentials}
 			if err = d.Set("s3_credentials", s3CredentialsList); err != nil {
 				return diag.FromErr(err)
@@ -505,10 +771,8 @@ func resourceStorageConfigRead(
 		azCredentialsInterface := d.Get("azure_credentials").([]interface{})
 		if len(azCredentialsInterface) > 0 && azCredentialsInterface[0] != nil {
 			azCredentials := utils.MapFromSingletonList(azCredentialsInterface)
-			sasToken := azCredentials["sas_token"]
-			if sasToken != nil && len(sasToken.(string)) > 0 {
-				azCredentials["sas_token"] = config.GetData()[utils.AzureStorageSasTokenEnv]
-			}
+			azCredentials["sas_token"] = storedCredential(azCredentials["sas_token"].(string),
+				config.GetData()[utils.AzureStorageSasTokenEnv])
 			azCredentialsList := []map[string]interface{}{azCredentials}
 			if err = d.Set("azure_credentials", azCredentialsList); err != nil {
 				return diag.FromErr(err)
@@ -525,11 +789,10 @@ func resourceStorageConfigRead(
 		gcsCredentialsInterface := d.Get("gcs_credentials").([]interface{})
 		if len(gcsCredentialsInterface) > 0 && gcsCredentialsInterface[0] != nil {
 			gcsCredentials := utils.MapFromSingletonList(gcsCredentialsInterface)
-			applicationCredentials := gcsCredentials["application_credentials"]
-			if applicationCredentials != nil && len(applicationCredentials.(string)) > 0 {
-				gcsCredentials["application_credentials"] = strings.Trim(strings.ReplaceAll(
-					config.GetData()[utils.GCSCredentialsJSON].(string), "\n", ""), "\"")
-			}
+			storedCredentials, _ := config.GetData()[utils.GCSCredentialsJSON].(string)
+			gcsCredentials["application_credentials"] = storedCredential(
+				strings.ReplaceAll(gcsCredentials["application_credentials"].(string), "\n", ""),
+				strings.Trim(strings.ReplaceAll(storedCredentials, "\n", ""), "\""))
 			gcsCredentialsList := []map[string]interface{}{gcsCredentials}
 			if err = d.Set("gcs_credentials", gcsCredentialsList); err != nil {
 				return diag.FromErr(err)
@@ -563,10 +826,39 @@ func resourceStorageConfigUpdate(
 	c := meta.(*api.APIClient).YugawareClient
 	cUUID := meta.(*api.APIClient).CustomerID
 
-	data, err := buildData(ctx, d)
+	if d.HasChange("ca_certificate") {
+		err := updateStorageConfigCACertificate(ctx, c, cUUID, d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	withCredentials := d.HasChanges(storageConfigCredentialFields()...)
+	data, err := buildData(ctx, d, withCredentials)
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	if !withCredentials {
+		// YugabyteDB Anywhere keeps the stored credentials when their obfuscated values
+		// are sent back
+		r, response, err := c.CustomerConfigurationApi.GetListOfCustomerConfig(ctx,
+			cUUID).Execute()
+		if err != nil {
+			errMessage := utils.ErrorFromHTTPResponse(response, err, utils.ResourceEntity,
+				"Storage Config", "Update - Fetch Config")
+			return diag.FromErr(errMessage)
+		}
+		config, err := findCustomerConfig(r, d.Id())
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		for _, k := range []string{utils.AWSAccessKeyEnv, utils.AWSSecretAccessKeyEnv,
+			utils.GCSCredentialsJSON, utils.AzureStorageSasTokenEnv} {
+			if v, ok := config.GetData()[k]; ok {
+				data[k] = v
+			}
+		}
+	}
 
 	req := client.CustomerConfig{
 		ConfigName:   d.Get("config_name").(string),
@@ -587,6 +879,45 @@ func resourceStorageConfigUpdate(
 	return resourceStorageConfigRead(ctx, d, meta)
 }
 
+func updateStorageConfigCACertificate(ctx context.Context, c *client.APIClient, cUUID string,
+	d *schema.ResourceData) error {
+	certUUID := d.Get("ca_certificate_uuid").(string)
+	contents := d.Get("ca_certificate").(string)
+	cert := client.CustomCACertParams{
+		Name:     d.Get("config_name").(string),
+		Contents: contents,
+	}
+	switch {
+	case certUUID == "":
+		newCertUUID, response, err := c.CustomCACertificatesApi.AddCA(ctx, cUUID).
+			X509CACertificate(cert).Execute()
+		if err != nil {
+			return utils.ErrorFromHTTPResponse(response, err, utils.ResourceEntity,
+				"Storage Config", "Update - Add CA Certificate")
+		}
+		return d.Set("ca_certificate_uuid", newCertUUID)
+	case contents == "":
+		_, response, err := c.CustomCACertificatesApi.DeleteCustomCACertificate(ctx, cUUID,
+			certUUID).Execute()
+		if err != nil {
+			return utils.ErrorFromHTTPResponse(response, err, utils.ResourceEntity,
+				"Storage Config", "Update - Delete CA Certificate")
+		}
+		return d.Set("ca_certificate_uuid", "")
+	default:
+		newCertUUID, response, err := c.CustomCACertificatesApi.UpdateCA(ctx, cUUID, certUUID).
+			X509CACertificate(cert).Execute()
+		if err != nil {
+			return utils.ErrorFromHTTPResponse(response, err, utils.ResourceEntity,
+				"Storage Config", "Update - Update CA Certificate")
+		}
+		if newCertUUID == "" {
+			return nil
+		}
+		return d.Set("ca_certificate_uuid", newCertUUID)
+	}
+}
+
 func resourceStorageConfigDelete(
 	ctx context.Context,
 	d *schema.ResourceData,
@@ -603,6 +934,16 @@ func resourceStorageConfigDelete(
 		return diag.FromErr(errMessage)
 	}
 
+	if certUUID := d.Get("ca_certificate_uuid").(string); certUUID != "" {
+		_, response, err = c.CustomCACertificatesApi.DeleteCustomCACertificate(ctx, cUUID,
+			certUUID).Execute()
+		if err != nil {
+			errMessage := utils.ErrorFromHTTPResponse(response, err, utils.ResourceEntity,
+				"Storage Config", "Delete - Delete CA Certificate")
+			return diag.FromErr(errMessage)
+		}
+	}
+
 	d.SetId("")
 	return diags
 }
@@ -0,0 +1,259 @@
+// Licensed to YugabyteDB, Inc. under one or more contributor license
+// agreements. See the NOTICE file distributed with this work for
+// additional information regarding copyright ownership. Yugabyte
+// licenses this file to you under the Mozilla License, Version 2.0
+// (the "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+// http://mozilla.org/MPL/2.0/.
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package cloud_provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	client "github.com/yugabyte/platform-go-client"
+	"github.com/yugabyte/terraform-provider-yba/internal/api"
+	"github.com/yugabyte/terraform-provider-yba/internal/utils"
+)
+
+// Provider retrieves the details of a provider
+func Provider() *schema.Resource {
+	return &schema.Resource{
+		Description: "Retrieve the details of a provider (cloud and onprem), including its " +
+			"regions, zones, image bundles, access keys and usage.",
+
+		ReadContext: dataSourceProviderRead,
+
+		Schema: map[string]*schema.Schema{
+			"provider_uuid": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"provider_uuid", "name"},
+				Description:  "UUID of the provider.",
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"provider_uuid", "name"},
+				Description:  "Name of the provider.",
+			},
+			"code": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Code of the provider.",
+			},
+			"usability_state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Usability state of the provider.",
+			},
+			"air_gap_install": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Flag indicating if the universes use an air-gapped installation.",
+			},
+			"ssh_user": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "User for ssh commands.",
+			},
+			"ssh_port": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Port for ssh commands.",
+			},
+			"ntp_servers": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "List of NTP Servers.",
+			},
+			"regions": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        RegionsSchema().Elem,
+				Description: "Regions of the provider.",
+			},
+			"image_bundles": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        ImageBundleSchema().Elem,
+				Description: "Image bundles of the provider.",
+			},
+			"access_keys": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Access keys of the provider. Private keys are not exposed.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key_code": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Key code.",
+						},
+						"key_pair_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "SSH Key Pair name.",
+						},
+						"management_state": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Management state of the access key.",
+						},
+						"creation_date": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Creation date of the access key.",
+						},
+						"expiration_date": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Expiration date of the access key.",
+						},
+					},
+				},
+			},
+			"in_use": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Flag indicating if the provider is used by universes.",
+			},
+			"universe_uuids": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "UUIDs of the universes using the provider.",
+			},
+		},
+	}
+}
+
+func flattenProviderAccessKeys(accessKeys []client.AccessKey) []map[string]interface{} {
+	res := make([]map[string]interface{}, 0)
+	for _, key := range accessKeys {
+		keyInfo := key.GetKeyInfo()
+		r := map[string]interface{}{
+			"key_code":         key.IdKey.GetKeyCode(),
+			"key_pair_name":    keyInfo.GetKeyPairName(),
+			"management_state": keyInfo.GetManagementState(),
+			"creation_date":    time.Time.String(key.GetCreationDate()),
+			"expiration_date":  time.Time.String(key.GetExpirationDate()),
+		}
+		res = append(res, r)
+	}
+	return res
+}
+
+func dataSourceProviderRead(
+	ctx context.Context,
+	d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	c := meta.(*api.APIClient).YugawareClient
+	cUUID := meta.(*api.APIClient).CustomerID
+
+	providers, response, err := c.CloudProvidersApi.GetListOfProviders(ctx, cUUID).Execute()
+	if err != nil {
+		errMessage := utils.ErrorFromHTTPResponse(response, err, utils.DataSourceEntity,
+			"Provider", "Read")
+		return diag.FromErr(errMessage)
+	}
+
+	var p *client.Provider
+	if pUUID := d.Get("provider_uuid").(string); len(pUUID) > 0 {
+		p, err = findProvider(providers, pUUID)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	} else {
+		name := d.Get("name").(string)
+		for i, provider := range providers {
+			if provider.GetName() == name {
+				p = &providers[i]
+				break
+			}
+		}
+		if p == nil {
+			return diag.FromErr(fmt.Errorf("could not find provider %s", name))
+		}
+	}
+
+	universes, response, err := c.UniverseManagementApi.ListUniverses(ctx, cUUID).Execute()
+	if err != nil {
+		errMessage := utils.ErrorFromHTTPResponse(response, err, utils.DataSourceEntity,
+			"Provider", "Read - List Universes")
+		return diag.FromErr(errMessage)
+	}
+	universeUUIDs := make([]string, 0)
+	for _, u := range universes {
+		uDetails := u.GetUniverseDetails()
+		for _, cluster := range uDetails.GetClusters() {
+			userIntent := cluster.GetUserIntent()
+			if userIntent.GetProvider() == p.GetUuid() {
+				universeUUIDs = append(universeUUIDs, u.GetUniverseUUID())
+				break
+			}
+		}
+	}
+
+	details := p.GetDetails()
+
+	if err = d.Set("provider_uuid", p.GetUuid()); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("name", p.GetName()); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("code", p.GetCode()); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("usability_state", p.GetUsabilityState()); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("air_gap_install", details.GetAirGapInstall()); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("ssh_user", details.GetSshUser()); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("ssh_port", details.GetSshPort()); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("ntp_servers", details.GetNtpServers()); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("regions", flattenRegions(p.GetRegions(), p.GetCode())); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("image_bundles", flattenImageBundles(p.GetImageBundles())); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("access_keys",
+		flattenProviderAccessKeys(p.GetAllAccessKeys())); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("in_use", len(universeUUIDs) > 0); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("universe_uuids", universeUUIDs); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(p.GetUuid())
+	return diags
+}
@@ -34,13 +34,11 @@ func ImageBundleSchema() *schema.Schema {
 				"uuid": {
 					Type:        schema.TypeString,
 					Computed:    true,
-					ForceNew:    true,
 					Description: "Image bundle UUID.",
 				},
 				"active": {
 					Type:        schema.TypeBool,
 					Computed:    true,
-					ForceNew:    true,
 					Description: "Is the image bundle active.",
 				},
 				"details": {
@@ -52,13 +50,11 @@ func ImageBundleSchema() *schema.Schema {
 							"arch": {
 								Type:        schema.TypeString,
 								Required:    true,
-								ForceNew:    true,
 								Description: "Image bundle architecture.",
 							},
 							"global_yb_image": {
 								Type:        schema.TypeString,
 								Optional:    true,
-								ForceNew:    true,
 								Description: "Global YB image for the bundle.",
 							},
 							"region_overrides": {
@@ -108,7 +104,6 @@ func ImageBundleSchema() *schema.Schema {
 				"name": {
 					Type:        schema.TypeString,
 					Required:    true,
-					ForceNew:    true,
 					Description: "Name of the image bundle.",
 				},
 				"use_as_default": {
@@ -214,3 +209,69 @@ func flattenImageBundleMetadata(
 	res = append(res, r)
 	return res
 }
+
+// trackedImageBundles returns the image bundles of the provider tracked in the state, matched
+// by UUID or name. All image bundles are tracked when the state has none, such as on import.
+// Image bundles added outside of the image_bundles blocks, like those of yba_image_bundle,
+// are not tracked.
+func trackedImageBundles(
+	imageBundles []client.ImageBundle, state []interface{},
+) []client.ImageBundle {
+	if len(state) == 0 {
+		return imageBundles
+	}
+	res := make([]client.ImageBundle, 0)
+	for _, b := range imageBundles {
+		if imageBundleInState(b, state) {
+			res = append(res, b)
+		}
+	}
+	return res
+}
+
+func imageBundleInState(bundle client.ImageBundle, state []interface{}) bool {
+	for _, s := range state {
+		b, isMap := s.(map[string]interface{})
+		if !isMap {
+			continue
+		}
+		if uuid, _ := b["uuid"].(string); uuid != "" && uuid == bundle.GetUuid() {
+			return true
+		}
+		if name, _ := b["name"].(string); name != "" && name == bundle.GetName() {
+			return true
+		}
+	}
+	return false
+}
+
+// createRequestForEditImageBundles keeps the UUIDs of the image bundles of the provider
+// that are still configured, matched by name. Bundles tracked in the state that are missing
+// from the configuration are removed from the provider, bundles that are not tracked in the
+// state are kept.
+func createRequestForEditImageBundles(
+	old, new []client.ImageBundle, state []interface{},
+) (req []client.ImageBundle) {
+	oldBundles := make(map[string]client.ImageBundle)
+	for _, o := range old {
+		oldBundles[o.GetName()] = o
+	}
+	newBundleNames := make(map[string]bool)
+	for _, n := range new {
+		newBundleNames[n.GetName()] = true
+		if o, exists := oldBundles[n.GetName()]; exists {
+			n.SetUuid(o.GetUuid())
+			n.SetActive(o.GetActive())
+			if o.Metadata != nil {
+				n.SetMetadata(o.GetMetadata())
+			}
+		}
+		req = append(req, n)
+	}
+	for _, o := range old {
+		if !newBundleNames[o.GetName()] && !imageBundleInState(o, state) {
+			req = append(req, o)
+		}
+	}
+	return req
+}
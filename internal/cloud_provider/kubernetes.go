@@ -0,0 +1,364 @@
+// Licensed to YugabyteDB, Inc. under one or more contributor license
+// agreements. See the NOTICE file distributed with this work for
+// additional information regarding copyright ownership. Yugabyte
+// licenses this file to you under the Mozilla License, Version 2.0
+// (the "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+// http://mozilla.org/MPL/2.0/.
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package cloud_provider
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	client "github.com/yugabyte/platform-go-client"
+	"github.com/yugabyte/terraform-provider-yba/internal/utils"
+)
+
+// kubernetesNamespaceRegex matches a DNS-1123 label, as required for namespaces
+var kubernetesNamespaceRegex = regexp.MustCompile(`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`)
+
+// KubernetesConfigSettingsSchema manages provider level settings of Kubernetes providers
+func KubernetesConfigSettingsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"kubernetes_provider": {
+					Type:     schema.TypeString,
+					Required: true,
+					ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(
+						[]string{"gke", "eks", "aks", "openshift", "tanzu", "custom"}, false)),
+					Description: "Kubernetes distribution hosting the universes. Permitted values: " +
+						"gke, eks, aks, openshift, tanzu, custom.",
+				},
+				"kube_config_content": {
+					Type:      schema.TypeString,
+					Optional:  true,
+					Sensitive: true,
+					Description: "Contents of the kubeconfig file used for all zones that do not " +
+						"set their own. The service account of YugabyteDB Anywhere is used if " +
+						"not set.",
+				},
+				"kube_config_name": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Computed:    true,
+					Description: "File name of the kubeconfig.",
+				},
+				"image_registry": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Computed:    true,
+					Description: "Registry to pull the YugabyteDB images from.",
+				},
+				"pull_secret_content": {
+					Type:      schema.TypeString,
+					Optional:  true,
+					Sensitive: true,
+					Description: "Contents of the Kubernetes secret (YAML) used to pull images " +
+						"from the registry.",
+				},
+				"pull_secret_name": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Computed:     true,
+					RequiredWith: []string{"kubernetes_config_settings.0.pull_secret_content"},
+					Description:  "File name of the pull secret.",
+				},
+				"image_pull_secret_name": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Name of the image pull secret in Kubernetes.",
+				},
+				"storage_class": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Computed:    true,
+					Description: "Storage class used for the volumes of all zones.",
+				},
+				"service_account": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Computed: true,
+					Description: "Service account whose credentials YugabyteDB Anywhere uses " +
+						"when no kubeconfig is provided.",
+				},
+			},
+		},
+		Description: "Settings that can be configured for Kubernetes.",
+	}
+}
+
+// KubernetesZoneSettingsSchema manages zone level settings of Kubernetes providers
+func KubernetesZoneSettingsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Computed: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"kube_config_content": {
+					Type:      schema.TypeString,
+					Optional:  true,
+					Sensitive: true,
+					Description: "Contents of the kubeconfig file of the cluster hosting " +
+						"the zone. Overrides kubernetes_config_settings.kube_config_content.",
+				},
+				"kube_config_name": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Computed:    true,
+					Description: "File name of the kubeconfig.",
+				},
+				"kube_namespace": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Computed:    true,
+					Description: "Namespace the universe pods are created in.",
+				},
+				"kube_domain": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Computed:    true,
+					Description: "Cluster domain. Default is cluster.local.",
+				},
+				"kube_pod_address_template": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Computed: true,
+					Description: "Template of the pod addresses, used for multi-cluster " +
+						"deployments.",
+				},
+				"storage_class": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Computed:    true,
+					Description: "Storage class used for the volumes of the zone.",
+				},
+				"overrides": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Computed:    true,
+					Description: "Helm chart overrides (YAML) applied to the zone.",
+				},
+				"cert_manager_issuer": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Computed:    true,
+					Description: "cert-manager Issuer used for the TLS certificates of the zone.",
+				},
+				"cert_manager_cluster_issuer": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Computed: true,
+					Description: "cert-manager ClusterIssuer used for the TLS certificates " +
+						"of the zone.",
+				},
+			},
+		},
+		Description: "Zone settings for Kubernetes providers.",
+	}
+}
+
+func buildKubernetesCloudInfo(settings []interface{}) client.KubernetesInfo {
+	k8sCloudInfo := client.KubernetesInfo{}
+	if len(settings) == 0 || settings[0] == nil {
+		return k8sCloudInfo
+	}
+	s := utils.MapFromSingletonList(settings)
+	k8sCloudInfo.SetKubernetesProvider(s["kubernetes_provider"].(string))
+	if content := s["kube_config_content"].(string); len(content) > 0 {
+		k8sCloudInfo.SetKubeConfigContent(content)
+	}
+	if name := s["kube_config_name"].(string); len(name) > 0 {
+		k8sCloudInfo.SetKubeConfigName(name)
+	}
+	if registry := s["image_registry"].(string); len(registry) > 0 {
+		k8sCloudInfo.SetKubernetesImageRegistry(registry)
+	}
+	if secret := s["pull_secret_content"].(string); len(secret) > 0 {
+		k8sCloudInfo.SetKubernetesPullSecretContent(secret)
+	}
+	if name := s["pull_secret_name"].(string); len(name) > 0 {
+		k8sCloudInfo.SetKubernetesPullSecretName(name)
+	}
+	if storageClass := s["storage_class"].(string); len(storageClass) > 0 {
+		k8sCloudInfo.SetKubernetesStorageClass(storageClass)
+	}
+	if serviceAccount := s["service_account"].(string); len(serviceAccount) > 0 {
+		k8sCloudInfo.SetKubernetesServiceAccount(serviceAccount)
+	}
+	return k8sCloudInfo
+}
+
+func buildKubernetesZoneDetails(settings []interface{}) *client.AvailabilityZoneDetails {
+	if len(settings) == 0 || settings[0] == nil {
+		return nil
+	}
+	s := utils.MapFromSingletonList(settings)
+	k8sRegionInfo := client.KubernetesRegionInfo{}
+	if content := s["kube_config_content"].(string); len(content) > 0 {
+		k8sRegionInfo.SetKubeConfigContent(content)
+	}
+	if name := s["kube_config_name"].(string); len(name) > 0 {
+		k8sRegionInfo.SetKubeConfigName(name)
+	}
+	if namespace := s["kube_namespace"].(string); len(namespace) > 0 {
+		k8sRegionInfo.SetKubeNamespace(namespace)
+	}
+	if domain := s["kube_domain"].(string); len(domain) > 0 {
+		k8sRegionInfo.SetKubeDomain(domain)
+	}
+	if template := s["kube_pod_address_template"].(string); len(template) > 0 {
+		k8sRegionInfo.SetKubePodAddressTemplate(template)
+	}
+	if storageClass := s["storage_class"].(string); len(storageClass) > 0 {
+		k8sRegionInfo.SetKubernetesStorageClass(storageClass)
+	}
+	if overrides := s["overrides"].(string); len(overrides) > 0 {
+		k8sRegionInfo.SetOverrides(overrides)
+	}
+	if issuer := s["cert_manager_issuer"].(string); len(issuer) > 0 {
+		k8sRegionInfo.SetCertManagerIssuer(issuer)
+	}
+	if issuer := s["cert_manager_cluster_issuer"].(string); len(issuer) > 0 {
+		k8sRegionInfo.SetCertManagerClusterIssuer(issuer)
+	}
+	return &client.AvailabilityZoneDetails{
+		CloudInfo: &client.AZCloudInfo{
+			Kubernetes: &k8sRegionInfo,
+		},
+	}
+}
+
+func flattenKubernetesZoneDetails(
+	details client.AvailabilityZoneDetails,
+) []map[string]interface{} {
+	res := make([]map[string]interface{}, 0)
+	cloudInfo := details.GetCloudInfo()
+	if cloudInfo.Kubernetes == nil {
+		return res
+	}
+	k8s := cloudInfo.GetKubernetes()
+	r := map[string]interface{}{
+		"kube_config_name":            k8s.GetKubeConfigName(),
+		"kube_namespace":              k8s.GetKubeNamespace(),
+		"kube_domain":                 k8s.GetKubeDomain(),
+		"kube_pod_address_template":   k8s.GetKubePodAddressTemplate(),
+		"storage_class":               k8s.GetKubernetesStorageClass(),
+		"overrides":                   k8s.GetOverrides(),
+		"cert_manager_issuer":         k8s.GetCertManagerIssuer(),
+		"cert_manager_cluster_issuer": k8s.GetCertManagerClusterIssuer(),
+	}
+	res = append(res, r)
+	return res
+}
+
+// preserveKubeConfigContent copies the configured zone kubeconfigs into the flattened
+// regions, since YugabyteDB Anywhere only returns the path of the stored file
+func preserveKubeConfigContent(
+	regions []map[string]interface{},
+	stateRegions []interface{},
+) {
+	contents := make(map[string]string)
+	for _, r := range stateRegions {
+		region := r.(map[string]interface{})
+		for _, z := range region["zones"].([]interface{}) {
+			zone := z.(map[string]interface{})
+			settings := zone["kubernetes_settings"].([]interface{})
+			if len(settings) == 0 || settings[0] == nil {
+				continue
+			}
+			s := utils.MapFromSingletonList(settings)
+			key := fmt.Sprintf("%s/%s", region["code"].(string), zone["name"].(string))
+			contents[key] = s["kube_config_content"].(string)
+		}
+	}
+	for _, region := range regions {
+		code := ""
+		if region["code"] != nil {
+			code = *region["code"].(*string)
+		}
+		for _, zone := range region["zones"].([]map[string]interface{}) {
+			settings := zone["kubernetes_settings"].([]map[string]interface{})
+			if len(settings) == 0 {
+				continue
+			}
+			key := fmt.Sprintf("%s/%s", code, zone["name"].(string))
+			settings[0]["kube_config_content"] = contents[key]
+		}
+	}
+}
+
+// validateKubernetesSettings checks the Kubernetes settings of the provider and its zones
+func validateKubernetesSettings(d *schema.ResourceDiff) error {
+	if len(d.Get("kubernetes_config_settings").([]interface{})) == 0 {
+		return fmt.Errorf("kubernetes_config_settings is required for Kubernetes providers")
+	}
+	for _, block := range []string{"aws_config_settings", "azure_config_settings",
+		"gcp_config_settings"} {
+		if len(d.Get(block).([]interface{})) > 0 {
+			return fmt.Errorf("%s cannot be set for Kubernetes providers", block)
+		}
+	}
+	for _, r := range d.Get("regions").([]interface{}) {
+		region := r.(map[string]interface{})
+		for _, z := range region["zones"].([]interface{}) {
+			zone := z.(map[string]interface{})
+			if len(zone["subnet"].(string)) > 0 || len(zone["secondary_subnet"].(string)) > 0 {
+				return fmt.Errorf("Zone %s: subnets cannot be set for Kubernetes providers",
+					zone["name"].(string))
+			}
+			settings := zone["kubernetes_settings"].([]interface{})
+			if len(settings) == 0 || settings[0] == nil {
+				continue
+			}
+			s := utils.MapFromSingletonList(settings)
+			namespace := s["kube_namespace"].(string)
+			if len(namespace) > 0 && (len(namespace) > 63 ||
+				!kubernetesNamespaceRegex.MatchString(namespace)) {
+				return fmt.Errorf("Zone %s: kube_namespace %s is not a valid Kubernetes "+
+					"namespace", zone["name"].(string), namespace)
+			}
+			if len(s["cert_manager_issuer"].(string)) > 0 &&
+				len(s["cert_manager_cluster_issuer"].(string)) > 0 {
+				return fmt.Errorf("Zone %s: cert_manager_issuer and "+
+					"cert_manager_cluster_issuer cannot be set together", zone["name"].(string))
+			}
+		}
+	}
+	return nil
+}
+
+// validateNonKubernetesSettings rejects Kubernetes settings on other cloud providers
+func validateNonKubernetesSettings(d *schema.ResourceDiff) error {
+	if len(d.Get("kubernetes_config_settings").([]interface{})) > 0 {
+		return fmt.Errorf("kubernetes_config_settings can only be set for Kubernetes providers")
+	}
+	for _, r := range d.Get("regions").([]interface{}) {
+		region := r.(map[string]interface{})
+		for _, z := range region["zones"].([]interface{}) {
+			zone := z.(map[string]interface{})
+			settings := zone["kubernetes_settings"].([]interface{})
+			if len(settings) > 0 && settings[0] != nil {
+				return fmt.Errorf("Zone %s: kubernetes_settings can only be set for "+
+					"Kubernetes providers", zone["name"].(string))
+			}
+		}
+	}
+	return nil
+}
@@ -31,54 +31,46 @@ func RegionsSchema() *schema.Schema {
 		Description: "Regions associated with cloud providers.",
 		Type:        schema.TypeList,
 		Required:    true,
-		ForceNew:    true,
-
-		DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
-			// Regions cannot be altered in the present cloud provider config
-			// Therefore if a region is present (id is not null), all changes are ignored
-			return d.Id() != ""
-		},
 		Elem: &schema.Resource{
 			Schema: map[string]*schema.Schema{
 				"uuid": {
 					Type:        schema.TypeString,
 					Computed:    true,
-					ForceNew:    true,
 					Description: "Region UUID.",
 				},
 				"code": {
 					Type:        schema.TypeString,
 					Computed:    true,
 					Optional:    true,
-					ForceNew:    true,
 					Description: "Region code. Varies by cloud provider.",
 				},
 				"config": {
 					Type:        schema.TypeMap,
 					Elem:        schema.TypeString,
 					Computed:    true,
-					ForceNew:    true,
 					Description: "Config details corresponding to region.",
 				},
 				"latitude": {
 					Type:        schema.TypeFloat,
-					ForceNew:    true,
 					Computed:    true,
 					Optional:    true,
 					Description: "Latitude of the region.",
 				},
 				"longitude": {
 					Type:        schema.TypeFloat,
-					ForceNew:    true,
 					Optional:    true,
 					Computed:    true,
 					Description: "Longitude of the region.",
 				},
 				"name": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Computed:    true,
-					ForceNew:    true,
+					Type:     schema.TypeString,
+					Optional: true,
+					Computed: true,
+					DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+						// The region name is replaced by the region code in the state, regions
+						// are matched by code when the provider is edited
+						return len(old) > 0 && len(new) > 0
+					},
 					Description: "Name of the region. Varies by cloud provider.",
 				},
 				"security_group_id": {
@@ -117,61 +109,53 @@ func RegionsSchema() *schema.Schema {
 					Type:        schema.TypeList,
 					Optional:    true,
 					Computed:    true,
-					ForceNew:    true,
 					Description: "Zones associated with the region.",
 					Elem: &schema.Resource{
 						Schema: map[string]*schema.Schema{
 							"uuid": {
 								Type:        schema.TypeString,
 								Computed:    true,
-								ForceNew:    true,
 								Description: "Zone UUID.",
 							},
 							"active": {
 								Type:        schema.TypeBool,
 								Computed:    true,
-								ForceNew:    true,
 								Description: "Flag indicating if the zone is active.",
 							},
 							"code": {
 								Type:        schema.TypeString,
 								Optional:    true,
 								Computed:    true,
-								ForceNew:    true,
 								Description: "Code of the zone. Varies by cloud provider.",
 							},
 							"config": {
 								Type:        schema.TypeMap,
 								Elem:        schema.TypeString,
 								Computed:    true,
-								ForceNew:    true,
 								Description: "Configuration details corresponding to zone.",
 							},
 							"kube_config_path": {
 								Type:        schema.TypeString,
 								Computed:    true,
-								ForceNew:    true,
 								Description: "Path to Kubernetes configuration file.",
 							},
+							"kubernetes_settings": KubernetesZoneSettingsSchema(),
 							"name": {
 								Type:        schema.TypeString,
 								Optional:    true,
 								Computed:    true,
-								ForceNew:    true,
 								Description: "Name of the zone. Varies by cloud provider.",
 							},
 							"secondary_subnet": {
 								Type:        schema.TypeString,
 								Optional:    true,
 								Computed:    true,
-								ForceNew:    true,
 								Description: "The secondary subnet in the AZ.",
 							},
 							"subnet": {
 								Type:        schema.TypeString,
 								Optional:    true,
 								Computed:    true,
-								ForceNew:    true,
 								Description: "Subnet to use for this zone.",
 							},
 						},
@@ -195,7 +179,7 @@ func buildRegions(
 		r := client.Region{
 			Code:      utils.GetStringPointer(region["code"].(string)),
 			Name:      utils.GetStringPointer(region["name"].(string)),
-			Zones:     buildZones(region["zones"].([]interface{})),
+			Zones:     buildZones(region["zones"].([]interface{}), cloudCode),
 			Latitude:  utils.GetFloat64Pointer(region["latitude"].(float64)),
 			Longitude: utils.GetFloat64Pointer(region["longitude"].(float64)),
 		}
@@ -235,7 +219,7 @@ func buildRegions(
 	return res
 }
 
-func buildZones(zones []interface{}) (res []client.AvailabilityZone) {
+func buildZones(zones []interface{}, cloudCode string) (res []client.AvailabilityZone) {
 	for _, v := range zones {
 		zone := v.(map[string]interface{})
 		z := client.AvailabilityZone{
@@ -244,6 +228,9 @@ func buildZones(zones []interface{}) (res []client.AvailabilityZone) {
 			SecondarySubnet: utils.GetStringPointer(zone["secondary_subnet"].(string)),
 			Subnet:          utils.GetStringPointer(zone["subnet"].(string)),
 		}
+		if cloudCode == "kubernetes" {
+			z.Details = buildKubernetesZoneDetails(zone["kubernetes_settings"].([]interface{}))
+		}
 		res = append(res, z)
 	}
 	return res
@@ -292,12 +279,13 @@ func flattenRegions(regions []client.Region, cloudCode string) (res []map[string
 func flattenZones(zones []client.AvailabilityZone) (res []map[string]interface{}) {
 	for _, zone := range zones {
 		z := map[string]interface{}{
-			"uuid":             zone.Uuid,
-			"active":           zone.Active,
-			"config":           zone.GetConfig(),
-			"kube_config_path": zone.KubeconfigPath,
-			"secondary_subnet": zone.SecondarySubnet,
-			"subnet":           zone.Subnet,
+			"uuid":                zone.Uuid,
+			"active":              zone.Active,
+			"config":              zone.GetConfig(),
+			"kube_config_path":    zone.KubeconfigPath,
+			"kubernetes_settings": flattenKubernetesZoneDetails(zone.GetDetails()),
+			"secondary_subnet":    zone.SecondarySubnet,
+			"subnet":              zone.Subnet,
 			// TODO: the region name/code is being changed by the server, which messes with terraform state
 			// https://yugabyte.atlassian.net/browse/PLAT-3034
 			"name": zone.Name,
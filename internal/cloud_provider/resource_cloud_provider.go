@@ -17,6 +17,7 @@ package cloud_provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
 	"strconv"
@@ -40,6 +41,7 @@ func ResourceCloudProvider() *schema.Resource {
 
 		CreateContext: resourceCloudProviderCreate,
 		ReadContext:   resourceCloudProviderRead,
+		UpdateContext: resourceCloudProviderUpdate,
 		DeleteContext: resourceCloudProviderDelete,
 
 		Importer: &schema.ResourceImporter{
@@ -48,6 +50,7 @@ func ResourceCloudProvider() *schema.Resource {
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
 			Delete: schema.DefaultTimeout(5 * time.Minute),
 		},
 
@@ -57,7 +60,6 @@ func ResourceCloudProvider() *schema.Resource {
 			"air_gap_install": {
 				Type:     schema.TypeBool,
 				Optional: true,
-				ForceNew: true,
 				Description: "Flag indicating if the universe should use an air-gapped " +
 					"installation.",
 			},
@@ -66,8 +68,9 @@ func ResourceCloudProvider() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(
-					[]string{"gcp", "aws", "azu"}, false)),
-				Description: "Code of the cloud provider. Permitted values: gcp, aws, azu.",
+					[]string{"gcp", "aws", "azu", "kubernetes"}, false)),
+				Description: "Code of the cloud provider. Permitted values: gcp, aws, azu, " +
+					"kubernetes.",
 			},
 			"config": {
 				Type:     schema.TypeMap,
@@ -110,16 +113,32 @@ func ResourceCloudProvider() *schema.Resource {
 			"key_pair_name": {
 				Type:        schema.TypeString,
 				Optional:    true,
-				ForceNew:    true,
 				Description: "Access Key Pair name.",
 			},
 			"name": {
 				Type:        schema.TypeString,
 				Required:    true,
-				ForceNew:    true,
 				Description: "Name of the provider.",
 			},
+			"ntp_servers": {
+				Type:     schema.TypeList,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Optional: true,
+				Computed: true,
+				Description: "List of NTP Servers. Chrony is set up on the universe nodes " +
+					"when NTP servers are provided, otherwise the cloud provider's time " +
+					"service is used.",
+			},
 			"regions": RegionsSchema(),
+			"skip_validation": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				Description: "Skip the validation of the provider configuration (credentials, " +
+					"networks, subnets, security groups and images) by YugabyteDB Anywhere " +
+					"before the provider is created or edited. False by default. Validation " +
+					"errors are reported against the corresponding attributes.",
+			},
 			"ssh_port": {
 				Type:     schema.TypeInt,
 				Optional: true,
@@ -133,7 +152,6 @@ func ResourceCloudProvider() *schema.Resource {
 			"ssh_private_key_content": {
 				Type:        schema.TypeString,
 				Optional:    true,
-				ForceNew:    true,
 				Description: "Private key to use for ssh commands.",
 			},
 			"ssh_user": {
@@ -147,7 +165,6 @@ func ResourceCloudProvider() *schema.Resource {
 
 					return len(old) > 0 && len(new) == 0
 				},
-				ForceNew: true,
 				Deprecated: "Deprecated since YugabyteDB Anywhere 2.20.3.0. " +
 					"Please use 'image_bundles[*].details.ssh_user' instead.",
 				Description: "User to use for ssh commands. " +
@@ -163,7 +180,6 @@ func ResourceCloudProvider() *schema.Resource {
 						"hosted_zone_id": {
 							Type:     schema.TypeString,
 							Optional: true,
-							ForceNew: true,
 							Description: "Hosted Zone ID for AWS corresponsding to Amazon " +
 								"Route53.",
 						},
@@ -178,7 +194,6 @@ func ResourceCloudProvider() *schema.Resource {
 							Type:      schema.TypeString,
 							Optional:  true,
 							Sensitive: true,
-							ForceNew:  true,
 							DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
 								return len(old) > 0 && utils.ObfuscateString(new, 2) == old
 							},
@@ -189,7 +204,6 @@ func ResourceCloudProvider() *schema.Resource {
 							Type:      schema.TypeString,
 							Optional:  true,
 							Sensitive: true,
-							ForceNew:  true,
 							DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
 								return len(old) > 0 && utils.ObfuscateString(new, 2) == old
 							},
@@ -197,8 +211,8 @@ func ResourceCloudProvider() *schema.Resource {
 							Description: "AWS Secret Access Key. Can also be set using " +
 								"environment variable AWS_SECRET_ACCESS_KEY.",
 						},
+						"aws_credential_source": utils.AwsCredentialSourceSchema(),
 					}},
-				ForceNew:    true,
 				Description: "Settings that can be configured for AWS.",
 			},
 			"azure_config_settings": {
@@ -210,13 +224,11 @@ func ResourceCloudProvider() *schema.Resource {
 						"hosted_zone_id": {
 							Type:        schema.TypeString,
 							Optional:    true,
-							ForceNew:    true,
 							Description: "Private DNS Zone for Azure.",
 						},
 						"subscription_id": {
 							Type:         schema.TypeString,
 							Optional:     true,
-							ForceNew:     true,
 							RequiredWith: []string{"azure_config_settings.0.client_id"},
 							Description: "Azure Subscription ID. Can also be set using " +
 								"environment variable AZURE_SUBSCRIPTION_ID. Required with " +
@@ -225,7 +237,6 @@ func ResourceCloudProvider() *schema.Resource {
 						"resource_group": {
 							Type:         schema.TypeString,
 							Optional:     true,
-							ForceNew:     true,
 							RequiredWith: []string{"azure_config_settings.0.client_id"},
 							Description: "Azure Resource Group. Can also be set using " +
 								"environment variable AZURE_RG. Required with " +
@@ -234,7 +245,6 @@ func ResourceCloudProvider() *schema.Resource {
 						"tenant_id": {
 							Type:         schema.TypeString,
 							Optional:     true,
-							ForceNew:     true,
 							RequiredWith: []string{"azure_config_settings.0.client_id"},
 							Description: "Azure Tenant ID. Can also be set using " +
 								"environment variable AZURE_TENANT_ID. Required with " +
@@ -243,7 +253,6 @@ func ResourceCloudProvider() *schema.Resource {
 						"client_id": {
 							Type:     schema.TypeString,
 							Optional: true,
-							ForceNew: true,
 							Description: "Azure Client ID. Can also be set using " +
 								"environment variable AZURE_CLIENT_ID.",
 						},
@@ -251,7 +260,6 @@ func ResourceCloudProvider() *schema.Resource {
 							Type:      schema.TypeString,
 							Optional:  true,
 							Sensitive: true,
-							ForceNew:  true,
 							DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
 								return len(old) > 0 && utils.ObfuscateString(new, 2) == old
 							},
@@ -263,7 +271,6 @@ func ResourceCloudProvider() *schema.Resource {
 						"network_subscription_id": {
 							Type:     schema.TypeString,
 							Optional: true,
-							ForceNew: true,
 							Description: "Azure Network Subscription ID." +
 								"All network resources and NIC resouce of VMs will " +
 								"be created in this group. If left empty, the default subscription ID will be used.",
@@ -271,13 +278,11 @@ func ResourceCloudProvider() *schema.Resource {
 						"network_resource_group": {
 							Type:     schema.TypeString,
 							Optional: true,
-							ForceNew: true,
 							Description: "Azure Network Resource Group." +
 								"All network resources and NIC resouce of VMs will " +
 								"be created in this group. If left empty, the default resource group will be used.",
 						},
 					}},
-				ForceNew:    true,
 				Description: "Settings that can be configured for Azure.",
 			},
 			"gcp_config_settings": {
@@ -348,9 +353,9 @@ func ResourceCloudProvider() *schema.Resource {
 								"environment variable GOOGLE_APPLICATION_CREDENTIALS.",
 						},
 					}},
-				ForceNew:    true,
 				Description: "Settings that can be configured for GCP.",
 			},
+			"kubernetes_config_settings": KubernetesConfigSettingsSchema(),
 		},
 	}
 }
@@ -454,6 +459,11 @@ func resourceCloudProviderDiff() schema.CustomizeDiffFunc {
 					if len(configSettings) == 0 ||
 						(configSettings["access_key_id"] == nil ||
 							len(configSettings["access_key_id"].(string)) == 0) {
+						// credentials are resolved from aws_credential_source instead of env
+						if len(configSettings) > 0 &&
+							len(configSettings["aws_credential_source"].([]interface{})) > 0 {
+							return nil
+						}
 						_, isPresentAccessKeyID := os.LookupEnv(utils.AWSAccessKeyEnv)
 						if !isPresentAccessKeyID {
 							errorString = fmt.Sprintf("%s%s ", errorString, utils.AWSAccessKeyEnv)
@@ -518,6 +528,12 @@ func resourceCloudProviderDiff() schema.CustomizeDiffFunc {
 
 				return nil
 			}),
+		func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+			if d.Get("code").(string) == "kubernetes" {
+				return validateKubernetesSettings(d)
+			}
+			return validateNonKubernetesSettings(d)
+		},
 	)
 }
 
@@ -703,10 +719,22 @@ func buildCloudInfo(
 			if len(configSettings) == 0 ||
 				(configSettings["access_key_id"] == nil ||
 					len(configSettings["access_key_id"].(string)) == 0) {
-				awsCreds, err := utils.AwsCredentialsFromEnv()
+				var source *utils.AwsCredentialSource
+				if len(configSettings) > 0 {
+					source = utils.AwsCredentialSourceFromList(
+						configSettings["aws_credential_source"].([]interface{}))
+				}
+				awsCreds, err := utils.AwsCredentials(source)
 				if err != nil {
 					return cloudInfo, err
 				}
+				// the provider API has no field for the session token of temporary
+				// credentials, unlike storage configs and releases
+				if awsCreds.SessionToken != "" {
+					return cloudInfo, errors.New("AWS credentials with a session token, " +
+						"such as assumed roles or SSO profiles, cannot be used for cloud " +
+						"providers. Use long-term access keys or use_iam_instance_profile")
+				}
 				awsCloudInfo.SetAwsAccessKeyID(awsCreds.AccessKeyID)
 				awsCloudInfo.SetAwsAccessKeySecret(awsCreds.SecretAccessKey)
 			} else {
@@ -719,6 +747,9 @@ func buildCloudInfo(
 		}
 		cloudInfo.SetAws(awsCloudInfo)
 
+	} else if cloudCode == "kubernetes" {
+		cloudInfo.SetKubernetes(
+			buildKubernetesCloudInfo(d.Get("kubernetes_config_settings").([]interface{})))
 	}
 
 	return cloudInfo, nil
@@ -784,12 +815,13 @@ func resourceCloudProviderCreate(
 			CloudInfo:     &cloudInfo,
 		},
 	}
+	if ntpServers := d.Get("ntp_servers").([]interface{}); len(ntpServers) > 0 {
+		setNtpServers(req.Details, ntpServers)
+	}
 	r, response, err := c.CloudProvidersApi.CreateProviders(ctx, cUUID).CreateProviderRequest(
-		req).Execute()
+		req).Validate(!d.Get("skip_validation").(bool)).Execute()
 	if err != nil {
-		errMessage := utils.ErrorFromHTTPResponse(response, err, utils.ResourceEntity,
-			"Cloud Provider", "Create")
-		return diag.FromErr(errMessage)
+		return providerValidationDiags(d, req, response, err, "Create")
 	}
 
 	d.SetId(*r.ResourceUUID)
@@ -861,10 +893,19 @@ func resourceCloudProviderRead(
 	if err = d.Set("ssh_user", details.SshUser); err != nil {
 		return diag.FromErr(err)
 	}
-	if err = d.Set("regions", flattenRegions(p.Regions, p.GetCode())); err != nil {
+	if err = d.Set("ntp_servers", details.GetNtpServers()); err != nil {
 		return diag.FromErr(err)
 	}
-	if err = d.Set("image_bundles", flattenImageBundles(p.GetImageBundles())); err != nil {
+	regions := flattenRegions(p.Regions, p.GetCode())
+	if p.GetCode() == "kubernetes" {
+		preserveKubeConfigContent(regions, d.Get("regions").([]interface{}))
+	}
+	if err = d.Set("regions", regions); err != nil {
+		return diag.FromErr(err)
+	}
+	imageBundles := trackedImageBundles(p.GetImageBundles(),
+		d.Get("image_bundles").([]interface{}))
+	if err = d.Set("image_bundles", flattenImageBundles(imageBundles)); err != nil {
 		return diag.FromErr(err)
 	}
 
@@ -1022,9 +1063,154 @@ func resourceCloudProviderRead(
 			}
 		}
 	}
+	if p.GetCode() == "kubernetes" {
+		configInterface := d.Get("kubernetes_config_settings").([]interface{})
+		if len(configInterface) > 0 && configInterface[0] != nil {
+			// kubeconfig and pull secret contents are kept from the configuration
+			configSettings := utils.MapFromSingletonList(configInterface)
+			k8sCloudInfo := cloudInfo.GetKubernetes()
+			configSettings["kubernetes_provider"] = k8sCloudInfo.GetKubernetesProvider()
+			configSettings["kube_config_name"] = k8sCloudInfo.GetKubeConfigName()
+			configSettings["image_registry"] = k8sCloudInfo.GetKubernetesImageRegistry()
+			configSettings["pull_secret_name"] = k8sCloudInfo.GetKubernetesPullSecretName()
+			configSettings["image_pull_secret_name"] =
+				k8sCloudInfo.GetKubernetesImagePullSecretName()
+			configSettings["storage_class"] = k8sCloudInfo.GetKubernetesStorageClass()
+			configSettings["service_account"] = k8sCloudInfo.GetKubernetesServiceAccount()
+			configSettingsList := make([]interface{}, 0)
+			configSettingsList = append(configSettingsList, configSettings)
+			if err = d.Set("kubernetes_config_settings", configSettingsList); err != nil {
+				return diag.FromErr(err)
+			}
+		} else {
+			configSettingsList := make([]interface{}, 0)
+			if err = d.Set("kubernetes_config_settings", configSettingsList); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
 	return diags
 }
 
+func resourceCloudProviderUpdate(
+	ctx context.Context,
+	d *schema.ResourceData,
+	meta interface{},
+) diag.Diagnostics {
+	c := meta.(*api.APIClient).YugawareClient
+	cUUID := meta.(*api.APIClient).CustomerID
+	pUUID := d.Id()
+
+	if !d.HasChangesExcept("skip_validation") {
+		return resourceCloudProviderRead(ctx, d, meta)
+	}
+
+	allowed, version, err := providerYBAVersionCheck(ctx, c)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if !allowed {
+		return diag.FromErr(fmt.Errorf("Editing provider below version %s (or on restricted "+
+			"versions) is not supported, currently on %s", utils.YBAAllowEditProviderMinVersion,
+			version))
+	}
+
+	providers, response, err := c.CloudProvidersApi.GetListOfProviders(ctx, cUUID).Execute()
+	if err != nil {
+		errMessage := utils.ErrorFromHTTPResponse(response, err, utils.ResourceEntity,
+			"Cloud Provider", "Update - Fetch Latest Values")
+		return diag.FromErr(errMessage)
+	}
+
+	p, err := findProvider(providers, pUUID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	providerReq := *p
+
+	if d.HasChange("name") {
+		providerReq.SetName(d.Get("name").(string))
+	}
+
+	if d.HasChange("regions") {
+		regions := buildRegions(
+			ctx,
+			d.Get("regions").([]interface{}),
+			d.Get("code").(string),
+			allowed, version)
+		providerReq.SetRegions(utils.CreateRequestForEditRegions(providerReq.GetRegions(), regions,
+			utils.RegionCode))
+	}
+
+	if d.HasChange("image_bundles") {
+		state, config := d.GetChange("image_bundles")
+		imageBundles := buildImageBundles(config.([]interface{}))
+		providerReq.SetImageBundles(createRequestForEditImageBundles(
+			providerReq.GetImageBundles(), imageBundles, state.([]interface{})))
+	}
+
+	if d.HasChanges("key_pair_name", "ssh_private_key_content") {
+		// the new access key is appended, existing keys may still be used by universes
+		accessKey := client.AccessKey{
+			KeyInfo: client.KeyInfo{
+				KeyPairName: utils.GetStringPointer(d.Get("key_pair_name").(string)),
+				SshPrivateKeyContent: utils.GetStringPointer(
+					d.Get("ssh_private_key_content").(string)),
+			},
+		}
+		providerReq.SetAllAccessKeys(append(providerReq.GetAllAccessKeys(), accessKey))
+	}
+
+	details := providerReq.GetDetails()
+	if d.HasChange("air_gap_install") {
+		details.SetAirGapInstall(d.Get("air_gap_install").(bool))
+	}
+	if d.HasChange("ssh_port") {
+		details.SetSshPort(int32(d.Get("ssh_port").(int)))
+	}
+	if d.HasChange("ssh_user") {
+		details.SetSshUser(d.Get("ssh_user").(string))
+	}
+	if d.HasChange("ntp_servers") {
+		setNtpServers(&details, d.Get("ntp_servers").([]interface{}))
+	}
+	if d.HasChanges("aws_config_settings", "azure_config_settings", "gcp_config_settings",
+		"kubernetes_config_settings") {
+		// credentials are sent again only when the settings change, YugabyteDB Anywhere
+		// keeps the stored values otherwise
+		cloudInfo, err := buildCloudInfo(d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		details.SetCloudInfo(cloudInfo)
+	}
+	providerReq.SetDetails(details)
+
+	r, response, err := c.CloudProvidersApi.EditProvider(ctx, cUUID, pUUID).EditProviderRequest(
+		providerReq).Validate(!d.Get("skip_validation").(bool)).Execute()
+	if err != nil {
+		return providerValidationDiags(d, providerReq, response, err, "Update")
+	}
+
+	if r.TaskUUID != nil {
+		tflog.Debug(ctx, fmt.Sprintf("Waiting for provider %s to be updated", pUUID))
+		err = utils.WaitForTask(ctx, *r.TaskUUID, cUUID, c, d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceCloudProviderRead(ctx, d, meta)
+}
+
+// setNtpServers sets up chrony on the universe nodes when NTP servers are provided
+func setNtpServers(details *client.ProviderDetails, ntpServers []interface{}) {
+	servers := utils.StringSlice(ntpServers)
+	details.SetNtpServers(*servers)
+	details.SetSetUpChrony(len(*servers) > 0)
+}
+
 func resourceCloudProviderDelete(
 	ctx context.Context,
 	d *schema.ResourceData,
@@ -0,0 +1,197 @@
+// Licensed to YugabyteDB, Inc. under one or more contributor license
+// agreements. See the NOTICE file distributed with this work for
+// additional information regarding copyright ownership. Yugabyte
+// licenses this file to you under the Mozilla License, Version 2.0
+// (the "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+// http://mozilla.org/MPL/2.0/.
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package cloud_provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	client "github.com/yugabyte/platform-go-client"
+	"github.com/yugabyte/terraform-provider-yba/internal/api"
+	"github.com/yugabyte/terraform-provider-yba/internal/utils"
+)
+
+// ResourceImageBundle creates and maintains a single image bundle of a cloud provider
+func ResourceImageBundle() *schema.Resource {
+	imageBundleSchema := ImageBundleSchema().Elem.(*schema.Resource).Schema
+	return &schema.Resource{
+		Description: "Image bundle of a cloud provider. Supported from YugabyteDB Anywhere " +
+			"version: " + utils.YBAAllowImageBundlesMinVersion,
+
+		CreateContext: resourceImageBundleCreate,
+		ReadContext:   resourceImageBundleRead,
+		UpdateContext: resourceImageBundleUpdate,
+		DeleteContext: resourceImageBundleDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceImageBundleImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"provider_uuid": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "UUID of the cloud provider the image bundle belongs to.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the image bundle.",
+			},
+			"details":        imageBundleSchema["details"],
+			"use_as_default": imageBundleSchema["use_as_default"],
+			"active":         imageBundleSchema["active"],
+			"metadata":       imageBundleSchema["metadata"],
+		},
+	}
+}
+
+func buildImageBundleFromResource(d *schema.ResourceData) client.ImageBundle {
+	return client.ImageBundle{
+		Details:      buildImageBundleDetails(d.Get("details").([]interface{})),
+		Name:         utils.GetStringPointer(d.Get("name").(string)),
+		UseAsDefault: utils.GetBoolPointer(d.Get("use_as_default").(bool)),
+	}
+}
+
+func resourceImageBundleCreate(
+	ctx context.Context,
+	d *schema.ResourceData,
+	meta interface{},
+) diag.Diagnostics {
+	c := meta.(*api.APIClient).YugawareClient
+	cUUID := meta.(*api.APIClient).CustomerID
+	pUUID := d.Get("provider_uuid").(string)
+
+	allowed, version, err := imageBundlesYBAVersionCheck(ctx, c)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !allowed {
+		return diag.FromErr(
+			fmt.Errorf("Image bundles are not supported below version %s, currently on %s",
+				utils.YBAAllowImageBundlesMinVersion, version))
+	}
+
+	r, response, err := c.PreviewApi.CreateImageBundle(ctx, cUUID, pUUID).Body(
+		buildImageBundleFromResource(d)).Execute()
+	if err != nil {
+		errMessage := utils.ErrorFromHTTPResponse(response, err, utils.ResourceEntity,
+			"Image Bundle", "Create")
+		return diag.FromErr(errMessage)
+	}
+
+	d.SetId(r.GetUuid())
+	return resourceImageBundleRead(ctx, d, meta)
+}
+
+func resourceImageBundleRead(
+	ctx context.Context,
+	d *schema.ResourceData,
+	meta interface{},
+) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := meta.(*api.APIClient).YugawareClient
+	cUUID := meta.(*api.APIClient).CustomerID
+	pUUID := d.Get("provider_uuid").(string)
+
+	r, response, err := c.PreviewApi.GetImageBundle(ctx, cUUID, pUUID, d.Id()).Execute()
+	if err != nil {
+		errMessage := utils.ErrorFromHTTPResponse(response, err, utils.ResourceEntity,
+			"Image Bundle", "Read")
+		return diag.FromErr(errMessage)
+	}
+
+	if err = d.Set("name", r.GetName()); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("details", flattenImageBundleDetails(r.GetDetails())); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("use_as_default", r.GetUseAsDefault()); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("active", r.GetActive()); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("metadata", flattenImageBundleMetadata(r.GetMetadata())); err != nil {
+		return diag.FromErr(err)
+	}
+	return diags
+}
+
+func resourceImageBundleUpdate(
+	ctx context.Context,
+	d *schema.ResourceData,
+	meta interface{},
+) diag.Diagnostics {
+	c := meta.(*api.APIClient).YugawareClient
+	cUUID := meta.(*api.APIClient).CustomerID
+	pUUID := d.Get("provider_uuid").(string)
+
+	req := buildImageBundleFromResource(d)
+	req.SetUuid(d.Id())
+	_, response, err := c.PreviewApi.EditImageBundle(ctx, cUUID, pUUID, d.Id()).Body(
+		req).Execute()
+	if err != nil {
+		errMessage := utils.ErrorFromHTTPResponse(response, err, utils.ResourceEntity,
+			"Image Bundle", "Update")
+		return diag.FromErr(errMessage)
+	}
+	return resourceImageBundleRead(ctx, d, meta)
+}
+
+func resourceImageBundleDelete(
+	ctx context.Context,
+	d *schema.ResourceData,
+	meta interface{},
+) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := meta.(*api.APIClient).YugawareClient
+	cUUID := meta.(*api.APIClient).CustomerID
+	pUUID := d.Get("provider_uuid").(string)
+
+	_, response, err := c.PreviewApi.Delete(ctx, cUUID, pUUID, d.Id()).Execute()
+	if err != nil {
+		errMessage := utils.ErrorFromHTTPResponse(response, err, utils.ResourceEntity,
+			"Image Bundle", "Delete")
+		return diag.FromErr(errMessage)
+	}
+
+	d.SetId("")
+	return diags
+}
+
+func resourceImageBundleImport(
+	ctx context.Context,
+	d *schema.ResourceData,
+	meta interface{},
+) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("Image bundle import ID must be of the form " +
+			"<provider-uuid>/<image-bundle-uuid>")
+	}
+	if err := d.Set("provider_uuid", parts[0]); err != nil {
+		return nil, err
+	}
+	d.SetId(parts[1])
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,374 @@
+// Licensed to YugabyteDB, Inc. under one or more contributor license
+// agreements. See the NOTICE file distributed with this work for
+// additional information regarding copyright ownership. Yugabyte
+// licenses this file to you under the Mozilla License, Version 2.0
+// (the "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+// http://mozilla.org/MPL/2.0/.
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package cloud_provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	client "github.com/yugabyte/platform-go-client"
+	"github.com/yugabyte/terraform-provider-yba/internal/api"
+	"github.com/yugabyte/terraform-provider-yba/internal/utils"
+	"golang.org/x/exp/slices"
+)
+
+// ResourceProviderAccessKey adds an access key to a provider and rotates universes to it
+func ResourceProviderAccessKey() *schema.Resource {
+	return &schema.Resource{
+		Description: "Access key of a provider (cloud and onprem). Adds a new SSH access key " +
+			"to the provider and optionally rotates the access key of the listed universes " +
+			"to it. Removing the resource does not delete the access key from the provider.",
+
+		CreateContext: resourceProviderAccessKeyCreate,
+		ReadContext:   resourceProviderAccessKeyRead,
+		DeleteContext: resourceProviderAccessKeyDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"provider_uuid": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "UUID of the provider.",
+			},
+			"key_pair_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				Description: "Name of the new access key pair. YugabyteDB Anywhere generates " +
+					"a name if not set.",
+			},
+			"ssh_private_key_content": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+				ForceNew:  true,
+				Description: "Private key of the new access key. YugabyteDB Anywhere " +
+					"generates a key pair if not set.",
+			},
+			"universe_uuids": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Description: "Universes of the provider whose access key is rotated to the " +
+					"new key.",
+			},
+			"delete_old_key": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  true,
+				Description: "Delete the access keys used by the universes listed in " +
+					"universe_uuids once they are rotated to the new key. Keys still used by " +
+					"any universe of the provider are kept. True by default.",
+			},
+			"previous_key_code": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Key code of the access key used by the provider before this key.",
+			},
+			"key_code": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Key code of the new access key.",
+			},
+			"creation_date": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Creation date of the access key.",
+			},
+			"deleted_key_codes": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Key codes of the access keys deleted after the rotation.",
+			},
+			"rotation_tasks": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Access key rotation task UUID of each universe.",
+			},
+		},
+	}
+}
+
+// universeAccessKeyCodes returns the access key codes used by the clusters of the universe
+func universeAccessKeyCodes(u client.UniverseResp) []string {
+	res := make([]string, 0)
+	details := u.GetUniverseDetails()
+	for _, cluster := range details.GetClusters() {
+		userIntent := cluster.GetUserIntent()
+		keyCode := userIntent.GetAccessKeyCode()
+		if keyCode != "" && !slices.Contains(res, keyCode) {
+			res = append(res, keyCode)
+		}
+	}
+	return res
+}
+
+// latestAccessKey returns the most recently created access key
+func latestAccessKey(keys []client.AccessKey) *client.AccessKey {
+	var latest *client.AccessKey
+	for i, k := range keys {
+		if latest == nil || k.GetCreationDate().After(latest.GetCreationDate()) {
+			latest = &keys[i]
+		}
+	}
+	return latest
+}
+
+func resourceProviderAccessKeyCreate(
+	ctx context.Context,
+	d *schema.ResourceData,
+	meta interface{},
+) diag.Diagnostics {
+	c := meta.(*api.APIClient).YugawareClient
+	cUUID := meta.(*api.APIClient).CustomerID
+	vc := meta.(*api.APIClient).VanillaClient
+	token := meta.(*api.APIClient).APIKey
+	pUUID := d.Get("provider_uuid").(string)
+
+	allowed, version, err := providerYBAVersionCheck(ctx, c)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !allowed {
+		return diag.FromErr(fmt.Errorf("Adding access keys below version %s (or on restricted "+
+			"versions) is not supported, currently on %s", utils.YBAAllowEditProviderMinVersion,
+			version))
+	}
+
+	existingKeys, response, err := c.AccessKeysApi.List(ctx, cUUID, pUUID).Execute()
+	if err != nil {
+		errMessage := utils.ErrorFromHTTPResponse(response, err, utils.ResourceEntity,
+			"Provider Access Key", "Create - List Access Keys")
+		return diag.FromErr(errMessage)
+	}
+	existingKeyCodes := make(map[string]bool)
+	for _, k := range existingKeys {
+		existingKeyCodes[k.IdKey.GetKeyCode()] = true
+	}
+	previousKey := latestAccessKey(existingKeys)
+
+	providers, response, err := c.CloudProvidersApi.GetListOfProviders(ctx, cUUID).Execute()
+	if err != nil {
+		errMessage := utils.ErrorFromHTTPResponse(response, err, utils.ResourceEntity,
+			"Provider Access Key", "Create - Fetch Provider")
+		return diag.FromErr(errMessage)
+	}
+	p, err := findProvider(providers, pUUID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	providerReq := *p
+
+	// access keys without a key code are added to the provider
+	keyInfo := client.KeyInfo{}
+	if keyPairName := d.Get("key_pair_name").(string); len(keyPairName) > 0 {
+		keyInfo.SetKeyPairName(keyPairName)
+	}
+	if content := d.Get("ssh_private_key_content").(string); len(content) > 0 {
+		keyInfo.SetSshPrivateKeyContent(content)
+	}
+	allAccessKeys := append(providerReq.GetAllAccessKeys(), client.AccessKey{KeyInfo: keyInfo})
+	providerReq.SetAllAccessKeys(allAccessKeys)
+
+	r, response, err := c.CloudProvidersApi.EditProvider(ctx, cUUID, pUUID).EditProviderRequest(
+		providerReq).Execute()
+	if err != nil {
+		errMessage := utils.ErrorFromHTTPResponse(response, err, utils.ResourceEntity,
+			"Provider Access Key", "Create")
+		return diag.FromErr(errMessage)
+	}
+	if r.TaskUUID != nil {
+		tflog.Debug(ctx, fmt.Sprintf("Waiting for access key to be added to provider %s", pUUID))
+		err = utils.WaitForTask(ctx, *r.TaskUUID, cUUID, c, d.Timeout(schema.TimeoutCreate))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	keys, response, err := c.AccessKeysApi.List(ctx, cUUID, pUUID).Execute()
+	if err != nil {
+		errMessage := utils.ErrorFromHTTPResponse(response, err, utils.ResourceEntity,
+			"Provider Access Key", "Create - List Access Keys")
+		return diag.FromErr(errMessage)
+	}
+	newKeys := make([]client.AccessKey, 0)
+	for _, k := range keys {
+		if !existingKeyCodes[k.IdKey.GetKeyCode()] {
+			newKeys = append(newKeys, k)
+		}
+	}
+	newKey := latestAccessKey(newKeys)
+	if newKey == nil {
+		return diag.FromErr(fmt.Errorf("Could not find the new access key of provider %s",
+			pUUID))
+	}
+	keyCode := newKey.IdKey.GetKeyCode()
+	d.SetId(keyCode)
+
+	if previousKey != nil {
+		if err = d.Set("previous_key_code", previousKey.IdKey.GetKeyCode()); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	universeUUIDs := *utils.StringSlice(d.Get("universe_uuids").([]interface{}))
+	var diags diag.Diagnostics
+	if len(universeUUIDs) > 0 {
+		// the keys used by the listed universes before the rotation are the candidates
+		// for deletion
+		universes, _, err := utils.GetUniversesForProvider(ctx, c, cUUID, pUUID, "")
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		oldKeyCodes := make([]string, 0)
+		for _, u := range universes {
+			if !slices.Contains(universeUUIDs, u.GetUniverseUUID()) {
+				continue
+			}
+			for _, k := range universeAccessKeyCodes(u) {
+				if k != keyCode && !slices.Contains(oldKeyCodes, k) {
+					oldKeyCodes = append(oldKeyCodes, k)
+				}
+			}
+		}
+
+		tasks, err := vc.RotateAccessKey(ctx, cUUID, pUUID, api.AccessKeyRotationRequest{
+			NewKeyCode:    keyCode,
+			UniverseUUIDs: universeUUIDs,
+		}, token)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if err = d.Set("rotation_tasks", tasks); err != nil {
+			return diag.FromErr(err)
+		}
+		for universeUUID, taskUUID := range tasks {
+			tflog.Info(ctx, fmt.Sprintf("Waiting for access key of universe %s to be rotated "+
+				"to %s", universeUUID, keyCode))
+			err = utils.WaitForTask(ctx, taskUUID, cUUID, c, d.Timeout(schema.TimeoutCreate))
+			if err != nil {
+				return diag.FromErr(err)
+			}
+		}
+
+		if d.Get("delete_old_key").(bool) && len(oldKeyCodes) > 0 {
+			deleted, keyDiags := deleteUnusedAccessKeys(ctx, c, cUUID, pUUID, oldKeyCodes)
+			diags = append(diags, keyDiags...)
+			if keyDiags.HasError() {
+				return diags
+			}
+			if err = d.Set("deleted_key_codes", deleted); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
+	return append(diags, resourceProviderAccessKeyRead(ctx, d, meta)...)
+}
+
+// deleteUnusedAccessKeys deletes the access keys that are not used by any universe of the
+// provider. Keys still in use are kept with a warning.
+func deleteUnusedAccessKeys(ctx context.Context, c *client.APIClient, cUUID, pUUID string,
+	keyCodes []string) ([]string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	universes, _, err := utils.GetUniversesForProvider(ctx, c, cUUID, pUUID, "")
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	deleted := make([]string, 0)
+	for _, keyCode := range keyCodes {
+		inUse := make([]string, 0)
+		for _, u := range universes {
+			if slices.Contains(universeAccessKeyCodes(u), keyCode) {
+				inUse = append(inUse, u.GetName())
+			}
+		}
+		if len(inUse) > 0 {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("Access key %s was not deleted", keyCode),
+				Detail: fmt.Sprintf("Access key %s of provider %s is still used by universes "+
+					"%v", keyCode, pUUID, inUse),
+			})
+			continue
+		}
+		tflog.Info(ctx, fmt.Sprintf("Deleting access key %s of provider %s", keyCode, pUUID))
+		_, response, err := c.AccessKeysApi.DeleteAccesskey(ctx, cUUID, pUUID,
+			keyCode).Execute()
+		if err != nil {
+			errMessage := utils.ErrorFromHTTPResponse(response, err, utils.ResourceEntity,
+				"Provider Access Key", "Create - Delete Previous Access Key")
+			return deleted, append(diags, diag.FromErr(errMessage)...)
+		}
+		deleted = append(deleted, keyCode)
+	}
+	return deleted, diags
+}
+
+func resourceProviderAccessKeyRead(
+	ctx context.Context,
+	d *schema.ResourceData,
+	meta interface{},
+) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := meta.(*api.APIClient).YugawareClient
+	cUUID := meta.(*api.APIClient).CustomerID
+	pUUID := d.Get("provider_uuid").(string)
+
+	r, response, err := c.AccessKeysApi.Index(ctx, cUUID, pUUID, d.Id()).Execute()
+	if err != nil {
+		errMessage := utils.ErrorFromHTTPResponse(response, err, utils.ResourceEntity,
+			"Provider Access Key", "Read")
+		return diag.FromErr(errMessage)
+	}
+
+	keyInfo := r.GetKeyInfo()
+	if err = d.Set("key_pair_name", keyInfo.GetKeyPairName()); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("key_code", r.IdKey.GetKeyCode()); err != nil {
+		return diag.FromErr(err)
+	}
+	if r.CreationDate != nil {
+		if err = d.Set("creation_date", r.GetCreationDate().String()); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	return diags
+}
+
+func resourceProviderAccessKeyDelete(
+	ctx context.Context,
+	d *schema.ResourceData,
+	meta interface{},
+) diag.Diagnostics {
+	// the access key may be in use by universes of the provider, so it is kept
+	d.SetId("")
+	return nil
+}
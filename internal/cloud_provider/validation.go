@@ -0,0 +1,254 @@
+// Licensed to YugabyteDB, Inc. under one or more contributor license
+// agreements. See the NOTICE file distributed with this work for
+// additional information regarding copyright ownership. Yugabyte
+// licenses this file to you under the Mozilla License, Version 2.0
+// (the "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+// http://mozilla.org/MPL/2.0/.
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package cloud_provider
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	client "github.com/yugabyte/platform-go-client"
+	"github.com/yugabyte/terraform-provider-yba/internal/utils"
+)
+
+var (
+	regionFieldPath      = regexp.MustCompile(`^regions\[(\d+)\](?:\.zones\[(\d+)\])?\.(.+)$`)
+	imageBundleFieldPath = regexp.MustCompile(`^imageBundles\[(\d+)\]\.(.+)$`)
+	accessKeyFieldPath   = regexp.MustCompile(`^allAccessKeys\[\d+\]\.keyInfo\.(.+)$`)
+)
+
+// providerValidationFields maps the provider level fields reported by the YugabyteDB
+// Anywhere provider validation to the attributes of the resource
+var providerValidationFields = map[string]string{
+	"name":                                     "name",
+	"details.airGapInstall":                    "air_gap_install",
+	"details.ntpServers":                       "ntp_servers",
+	"details.sshPort":                          "ssh_port",
+	"details.sshUser":                          "ssh_user",
+	"details.cloudInfo.aws.awsAccessKeyID":     "aws_config_settings.0.access_key_id",
+	"details.cloudInfo.aws.awsAccessKeySecret": "aws_config_settings.0.secret_access_key",
+	"details.cloudInfo.aws.awsHostedZoneId":    "aws_config_settings.0.hosted_zone_id",
+	"details.cloudInfo.azu.azuClientId":        "azure_config_settings.0.client_id",
+	"details.cloudInfo.azu.azuClientSecret":    "azure_config_settings.0.client_secret",
+	"details.cloudInfo.azu.azuHostedZoneId":    "azure_config_settings.0.hosted_zone_id",
+	"details.cloudInfo.azu.azuNetworkRG":       "azure_config_settings.0.network_resource_group",
+	"details.cloudInfo.azu.azuNetworkSubscriptionId": "azure_config_settings.0." +
+		"network_subscription_id",
+	"details.cloudInfo.azu.azuRG":                     "azure_config_settings.0.resource_group",
+	"details.cloudInfo.azu.azuSubscriptionId":         "azure_config_settings.0.subscription_id",
+	"details.cloudInfo.azu.azuTenantId":               "azure_config_settings.0.tenant_id",
+	"details.cloudInfo.gcp.destVpcId":                 "gcp_config_settings.0.network",
+	"details.cloudInfo.gcp.gceApplicationCredentials": "gcp_config_settings.0.credentials",
+	"details.cloudInfo.gcp.gceProject":                "gcp_config_settings.0.project_id",
+	"details.cloudInfo.gcp.sharedVPCProject":          "gcp_config_settings.0.shared_vpc_project_id",
+}
+
+// regionValidationFields maps the region and zone level fields reported by the provider
+// validation to the attributes of the regions block
+var regionValidationFields = map[string]string{
+	"code":             "code",
+	"name":             "name",
+	"instanceTemplate": "instance_template",
+	"securityGroupId":  "security_group_id",
+	"vnet":             "vnet_name",
+	"ybImage":          "yb_image",
+	"subnet":           "subnet",
+	"secondarySubnet":  "secondary_subnet",
+}
+
+// imageBundleValidationFields maps the image bundle fields reported by the provider validation
+// to the attributes of the image_bundles block
+var imageBundleValidationFields = map[string]string{
+	"name":                  "name",
+	"details.arch":          "details.0.arch",
+	"details.globalYbImage": "details.0.global_yb_image",
+	"details.regions":       "details.0.region_overrides",
+	"details.sshPort":       "details.0.ssh_port",
+	"details.sshUser":       "details.0.ssh_user",
+}
+
+// providerValidationDiags converts the field errors returned by the YugabyteDB Anywhere
+// provider validation into diagnostics pointing at the attributes of the resource. Other
+// errors are reported as in utils.ErrorFromHTTPResponse
+func providerValidationDiags(d *schema.ResourceData, req client.Provider,
+	response *http.Response, apiError error, operation string) diag.Diagnostics {
+	if response == nil || response.StatusCode != http.StatusBadRequest {
+		return diag.FromErr(utils.ErrorFromHTTPResponse(response, apiError,
+			utils.ResourceEntity, "Cloud Provider", operation))
+	}
+	body, err := io.ReadAll(response.Body)
+	if err != nil {
+		return diag.FromErr(utils.ErrorFromHTTPResponse(response, apiError,
+			utils.ResourceEntity, "Cloud Provider", operation))
+	}
+	// the body is restored for the fallback to utils.ErrorFromHTTPResponse
+	response.Body = io.NopCloser(bytes.NewReader(body))
+
+	errorBlock := utils.YbaStructuredError{}
+	if err = json.Unmarshal(body, &errorBlock); err != nil || errorBlock.Error == nil {
+		return diag.FromErr(utils.ErrorFromHTTPResponse(response, apiError,
+			utils.ResourceEntity, "Cloud Provider", operation))
+	}
+	errorMap, isMap := (*errorBlock.Error).(map[string]interface{})
+	if !isMap {
+		return diag.FromErr(utils.ErrorFromHTTPResponse(response, apiError,
+			utils.ResourceEntity, "Cloud Provider", operation))
+	}
+
+	fields := make([]string, 0)
+	for k := range errorMap {
+		if k != "" && k != "errorSource" {
+			fields = append(fields, k)
+		}
+	}
+	if len(fields) == 0 {
+		return diag.FromErr(utils.ErrorFromHTTPResponse(response, apiError,
+			utils.ResourceEntity, "Cloud Provider", operation))
+	}
+	sort.Strings(fields)
+
+	var diags diag.Diagnostics
+	for _, field := range fields {
+		messages := make([]string, 0)
+		if v, isList := errorMap[field].([]interface{}); isList {
+			messages = append(messages, *utils.StringSlice(v)...)
+		} else {
+			messages = append(messages, fmt.Sprintf("%v", errorMap[field]))
+		}
+		path := validationAttributePath(d, req, field)
+		for _, message := range messages {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary: fmt.Sprintf("Cloud Provider, Operation: %s - Validation failed: %s",
+					operation, message),
+				Detail:        fmt.Sprintf("Field: %s", field),
+				AttributePath: path,
+			})
+		}
+	}
+	return diags
+}
+
+// validationAttributePath returns the attribute path of the resource corresponding to the
+// JSON path of the field reported by the provider validation. Regions, zones and image
+// bundles are matched by code and name since the request may order them differently from
+// the configuration. A nil path is returned if the field cannot be mapped.
+func validationAttributePath(d *schema.ResourceData, req client.Provider,
+	field string) cty.Path {
+	field = strings.TrimPrefix(field, "$.")
+
+	if attr, exists := providerValidationFields[field]; exists {
+		return attributePath(attr)
+	}
+
+	if m := accessKeyFieldPath.FindStringSubmatch(field); m != nil {
+		switch m[1] {
+		case "keyPairName":
+			return attributePath("key_pair_name")
+		case "sshPrivateKeyContent":
+			return attributePath("ssh_private_key_content")
+		}
+		return nil
+	}
+
+	if m := regionFieldPath.FindStringSubmatch(field); m != nil {
+		regionIndex, _ := strconv.Atoi(m[1])
+		if regionIndex >= len(req.GetRegions()) {
+			return nil
+		}
+		reqRegion := req.GetRegions()[regionIndex]
+		regions := d.Get("regions").([]interface{})
+		i := configIndex(regions, "code", reqRegion.GetCode())
+		if i < 0 {
+			return nil
+		}
+		attr := fmt.Sprintf("regions.%d", i)
+		if len(m[2]) > 0 {
+			zoneIndex, _ := strconv.Atoi(m[2])
+			if zoneIndex >= len(reqRegion.GetZones()) {
+				return attributePath(attr)
+			}
+			zones := regions[i].(map[string]interface{})["zones"].([]interface{})
+			j := configIndex(zones, "code", reqRegion.GetZones()[zoneIndex].GetCode())
+			if j < 0 {
+				return attributePath(attr)
+			}
+			attr = fmt.Sprintf("%s.zones.%d", attr, j)
+		}
+		// region cloud info fields are nested under details.cloudInfo.<code>
+		name := m[3][strings.LastIndex(m[3], ".")+1:]
+		if f, exists := regionValidationFields[name]; exists {
+			attr = fmt.Sprintf("%s.%s", attr, f)
+		}
+		return attributePath(attr)
+	}
+
+	if m := imageBundleFieldPath.FindStringSubmatch(field); m != nil {
+		bundleIndex, _ := strconv.Atoi(m[1])
+		if bundleIndex >= len(req.GetImageBundles()) {
+			return nil
+		}
+		i := configIndex(d.Get("image_bundles").([]interface{}), "name",
+			req.GetImageBundles()[bundleIndex].GetName())
+		if i < 0 {
+			return nil
+		}
+		attr := fmt.Sprintf("image_bundles.%d", i)
+		for k, f := range imageBundleValidationFields {
+			if m[2] == k || strings.HasPrefix(m[2], k+".") {
+				attr = fmt.Sprintf("%s.%s", attr, f)
+				break
+			}
+		}
+		return attributePath(attr)
+	}
+
+	return nil
+}
+
+// configIndex returns the index of the block in the list whose attribute has the value
+func configIndex(blocks []interface{}, attribute, value string) int {
+	for i, b := range blocks {
+		block, isMap := b.(map[string]interface{})
+		if isMap && block[attribute] == value {
+			return i
+		}
+	}
+	return -1
+}
+
+// attributePath converts a flatmap style attribute address (regions.0.zones.1.subnet) into
+// a cty.Path
+func attributePath(attr string) cty.Path {
+	var path cty.Path
+	for _, step := range strings.Split(attr, ".") {
+		if index, err := strconv.Atoi(step); err == nil {
+			path = path.IndexInt(index)
+		} else {
+			path = path.GetAttr(step)
+		}
+	}
+	return path
+}
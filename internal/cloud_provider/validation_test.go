@@ -0,0 +1,299 @@
+// Licensed to YugabyteDB, Inc. under one or more contributor license
+// agreements. See the NOTICE file distributed with this work for
+// additional information regarding copyright ownership. Yugabyte
+// licenses this file to you under the Mozilla License, Version 2.0
+// (the "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+// http://mozilla.org/MPL/2.0/.
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package cloud_provider
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	client "github.com/yugabyte/platform-go-client"
+	"github.com/yugabyte/terraform-provider-yba/internal/utils"
+)
+
+func TestAttributePath(t *testing.T) {
+	tests := []struct {
+		attr     string
+		expected cty.Path
+	}{
+		{
+			attr:     "name",
+			expected: cty.GetAttrPath("name"),
+		},
+		{
+			attr:     "aws_config_settings.0.access_key_id",
+			expected: cty.GetAttrPath("aws_config_settings").IndexInt(0).GetAttr("access_key_id"),
+		},
+		{
+			attr: "regions.1.zones.0.subnet",
+			expected: cty.GetAttrPath("regions").IndexInt(1).GetAttr("zones").IndexInt(0).
+				GetAttr("subnet"),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.attr, func(t *testing.T) {
+			got := attributePath(tc.attr)
+			if !got.Equals(tc.expected) {
+				t.Errorf("attributePath(%q) = %#v, expected %#v", tc.attr, got, tc.expected)
+			}
+		})
+	}
+}
+
+func TestConfigIndex(t *testing.T) {
+	blocks := []interface{}{
+		map[string]interface{}{"code": "us-west-1"},
+		nil,
+		map[string]interface{}{"code": "us-east-1"},
+	}
+
+	tests := []struct {
+		name     string
+		value    string
+		expected int
+	}{
+		{name: "first block", value: "us-west-1", expected: 0},
+		{name: "block after empty block", value: "us-east-1", expected: 2},
+		{name: "missing block", value: "eu-west-1", expected: -1},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := configIndex(blocks, "code", tc.value); got != tc.expected {
+				t.Errorf("configIndex(%q) = %d, expected %d", tc.value, got, tc.expected)
+			}
+		})
+	}
+}
+
+func TestValidationFieldPaths(t *testing.T) {
+	tests := []struct {
+		field  string
+		regexp *regexp.Regexp
+		match  []string
+	}{
+		{
+			field:  "regions[0].details.cloudInfo.aws.securityGroupId",
+			regexp: regionFieldPath,
+			match:  []string{"0", "", "details.cloudInfo.aws.securityGroupId"},
+		},
+		{
+			field:  "regions[2].zones[1].subnet",
+			regexp: regionFieldPath,
+			match:  []string{"2", "1", "subnet"},
+		},
+		{
+			field:  "imageBundles[1].details.globalYbImage",
+			regexp: imageBundleFieldPath,
+			match:  []string{"1", "details.globalYbImage"},
+		},
+		{
+			field:  "allAccessKeys[0].keyInfo.keyPairName",
+			regexp: accessKeyFieldPath,
+			match:  []string{"keyPairName"},
+		},
+		{
+			field:  "regions.code",
+			regexp: regionFieldPath,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.field, func(t *testing.T) {
+			m := tc.regexp.FindStringSubmatch(tc.field)
+			if tc.match == nil {
+				if m != nil {
+					t.Errorf("%q matched %s: %v", tc.field, tc.regexp, m)
+				}
+				return
+			}
+			if m == nil {
+				t.Fatalf("%q did not match %s", tc.field, tc.regexp)
+			}
+			for i, expected := range tc.match {
+				if m[i+1] != expected {
+					t.Errorf("group %d of %q = %q, expected %q", i+1, tc.field, m[i+1],
+						expected)
+				}
+			}
+		})
+	}
+}
+
+// schemaHasAttribute reports if the flatmap style attribute address exists in the schema
+func schemaHasAttribute(schemaMap map[string]*schema.Schema, attr string) bool {
+	steps := strings.Split(attr, ".")
+	for i := 0; i < len(steps); i++ {
+		s, exists := schemaMap[steps[i]]
+		if !exists {
+			return false
+		}
+		if i == len(steps)-1 {
+			return true
+		}
+		if s.Type == schema.TypeMap {
+			return i == len(steps)-2
+		}
+		r, isResource := s.Elem.(*schema.Resource)
+		if !isResource || i+2 >= len(steps) {
+			return false
+		}
+		// skip the list index
+		i++
+		schemaMap = r.Schema
+	}
+	return false
+}
+
+func TestValidationFieldMaps(t *testing.T) {
+	// every mapped attribute must exist in the schema of the resource
+	resourceSchema := ResourceCloudProvider().Schema
+	for field, attr := range providerValidationFields {
+		if !schemaHasAttribute(resourceSchema, attr) {
+			t.Errorf("providerValidationFields[%q] = %q is not an attribute", field, attr)
+		}
+	}
+	for field, attr := range regionValidationFields {
+		if !schemaHasAttribute(resourceSchema, "regions.0."+attr) &&
+			!schemaHasAttribute(resourceSchema, "regions.0.zones.0."+attr) {
+			t.Errorf("regionValidationFields[%q] = %q is not an attribute", field, attr)
+		}
+	}
+	for field, attr := range imageBundleValidationFields {
+		if !schemaHasAttribute(resourceSchema, "image_bundles.0."+attr) {
+			t.Errorf("imageBundleValidationFields[%q] = %q is not an attribute", field, attr)
+		}
+	}
+}
+
+func TestValidationAttributePath(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, ResourceCloudProvider().Schema, map[string]interface{}{
+		"code": "aws",
+		"name": "aws-provider",
+		"regions": []interface{}{
+			map[string]interface{}{
+				"code": "us-west-2",
+				"zones": []interface{}{
+					map[string]interface{}{"code": "us-west-2a", "subnet": "subnet-a"},
+					map[string]interface{}{"code": "us-west-2b", "subnet": "subnet-b"},
+				},
+			},
+			map[string]interface{}{
+				"code": "us-east-1",
+				"zones": []interface{}{
+					map[string]interface{}{"code": "us-east-1a", "subnet": "subnet-c"},
+				},
+			},
+		},
+		"image_bundles": []interface{}{
+			map[string]interface{}{
+				"name": "bundle-x86",
+				"details": []interface{}{
+					map[string]interface{}{"arch": "x86_64", "ssh_user": "ec2-user"},
+				},
+			},
+		},
+	})
+
+	// the request orders regions and zones differently from the configuration
+	req := client.Provider{
+		Regions: []client.Region{
+			{
+				Code: utils.GetStringPointer("us-east-1"),
+				Zones: []client.AvailabilityZone{
+					{Code: utils.GetStringPointer("us-east-1a")},
+				},
+			},
+			{
+				Code: utils.GetStringPointer("us-west-2"),
+				Zones: []client.AvailabilityZone{
+					{Code: utils.GetStringPointer("us-west-2b")},
+					{Code: utils.GetStringPointer("us-west-2a")},
+				},
+			},
+		},
+		ImageBundles: []client.ImageBundle{
+			{Name: utils.GetStringPointer("bundle-x86")},
+		},
+	}
+
+	tests := []struct {
+		field    string
+		expected cty.Path
+	}{
+		{
+			field:    "$.name",
+			expected: attributePath("name"),
+		},
+		{
+			field:    "details.cloudInfo.aws.awsAccessKeyID",
+			expected: attributePath("aws_config_settings.0.access_key_id"),
+		},
+		{
+			field:    "allAccessKeys[0].keyInfo.sshPrivateKeyContent",
+			expected: attributePath("ssh_private_key_content"),
+		},
+		{
+			field:    "allAccessKeys[0].keyInfo.keyPairId",
+			expected: nil,
+		},
+		{
+			field:    "$.regions[0].details.cloudInfo.aws.securityGroupId",
+			expected: attributePath("regions.1.security_group_id"),
+		},
+		{
+			field:    "regions[1].zones[0].subnet",
+			expected: attributePath("regions.0.zones.1.subnet"),
+		},
+		{
+			field:    "regions[1].zones[5].subnet",
+			expected: attributePath("regions.0"),
+		},
+		{
+			field:    "regions[1].unknownField",
+			expected: attributePath("regions.0"),
+		},
+		{
+			field:    "regions[4].code",
+			expected: nil,
+		},
+		{
+			field:    "imageBundles[0].details.regions.us-west-2",
+			expected: attributePath("image_bundles.0.details.0.region_overrides"),
+		},
+		{
+			field:    "imageBundles[3].name",
+			expected: nil,
+		},
+		{
+			field:    "unknownField",
+			expected: nil,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.field, func(t *testing.T) {
+			got := validationAttributePath(d, req, tc.field)
+			if !got.Equals(tc.expected) {
+				t.Errorf("validationAttributePath(%q) = %#v, expected %#v", tc.field, got,
+					tc.expected)
+			}
+		})
+	}
+}
@@ -230,7 +230,8 @@ func resourceInstallationCreate(
 	user := d.Get("ssh_user").(string)
 	pk := d.Get("ssh_private_key").(string)
 
-	sshClient, err := waitForIP(ctx, user, hostIPForSSH, pk, d.Timeout(schema.TimeoutCreate))
+	sshClient, err := WaitForIP(ctx, user, hostIPForSSH, DefaultSSHPort, pk,
+		d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		tflog.Error(ctx, "Timeout: Couldn't connect to YugabyteDB Anywhere host")
 		return diag.FromErr(err)
@@ -242,7 +243,7 @@ func resourceInstallationCreate(
 		if local == "" {
 			continue
 		}
-		err = scpFile(ctx, sshClient, local, remote)
+		err = ScpFile(ctx, sshClient, local, remote)
 		if err != nil {
 			tflog.Error(ctx, "Error occurred while transferring files required for installation")
 			return diag.FromErr(err)
@@ -250,7 +251,7 @@ func resourceInstallationCreate(
 	}
 
 	for _, cmd := range getInstallationCommands(publicIP, privateIP) {
-		m, err := runCommand(ctx, sshClient, cmd)
+		m, err := RunCommand(ctx, sshClient, cmd)
 		if err != nil {
 			tflog.Error(ctx, m)
 			return diag.FromErr(errors.New(m))
@@ -301,14 +302,14 @@ func resourceInstallationDelete(
 	user := d.Get("ssh_user").(string)
 	pk := d.Get("ssh_private_key").(string)
 
-	sshClient, err := newSSHClient(user, ip, pk)
+	sshClient, err := NewSSHClient(user, ip, DefaultSSHPort, pk)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 	defer sshClient.Close()
 
 	for _, cmd := range deletionCommands {
-		m, err := runCommand(ctx, sshClient, cmd)
+		m, err := RunCommand(ctx, sshClient, cmd)
 		if err != nil {
 			tflog.Error(ctx, m)
 		}
@@ -238,7 +238,8 @@ func resourceYBAInstallerCreate(
 		return diag.FromErr(err)
 	}
 
-	sshClient, err := waitForIP(ctx, user, hostIPForSSH, *pk, d.Timeout(schema.TimeoutCreate))
+	sshClient, err := WaitForIP(ctx, user, hostIPForSSH, DefaultSSHPort, *pk,
+		d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		tflog.Error(ctx, "Timeout: Couldn't connect to YugabyteDB Anywhere host")
 		return diag.FromErr(err)
@@ -250,7 +251,7 @@ func resourceYBAInstallerCreate(
 		if local == "" {
 			continue
 		}
-		err = scpFile(ctx, sshClient, local, remote)
+		err = ScpFile(ctx, sshClient, local, remote)
 		if err != nil {
 			tflog.Error(ctx, "Error occurred while transferring files required for installation")
 			return diag.FromErr(err)
@@ -272,7 +273,7 @@ func resourceYBAInstallerCreate(
 
 	for _, cmd := range getInstallCommands(ybaVersion, hostOS, hostArch, configExists,
 		skipPreflightChecksList) {
-		m, err := runCommand(ctx, sshClient, cmd)
+		m, err := RunCommand(ctx, sshClient, cmd)
 		if err != nil {
 			tflog.Error(ctx, m)
 			if m != "" {
@@ -308,7 +309,8 @@ func resourceYBAInstallerUpdate(
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	sshClient, err := waitForIP(ctx, user, hostIPForSSH, *pk, d.Timeout(schema.TimeoutCreate))
+	sshClient, err := WaitForIP(ctx, user, hostIPForSSH, DefaultSSHPort, *pk,
+		d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		tflog.Error(ctx, "Timeout: Couldn't connect to YugabyteDB Anywhere host")
 		return diag.FromErr(err)
@@ -334,7 +336,7 @@ func resourceYBAInstallerUpdate(
 			if local == "" {
 				continue
 			}
-			err = scpFile(ctx, sshClient, local, remote)
+			err = ScpFile(ctx, sshClient, local, remote)
 			if err != nil {
 				tflog.Error(ctx, "Error occurred while transferring files required for "+
 					"updating license")
@@ -359,7 +361,7 @@ func resourceYBAInstallerUpdate(
 			if local == "" {
 				continue
 			}
-			err = scpFile(ctx, sshClient, local, remote)
+			err = ScpFile(ctx, sshClient, local, remote)
 			if err != nil {
 				tflog.Error(ctx, "Error occurred while transferring files required for "+
 					"reconfiguration")
@@ -375,7 +377,7 @@ func resourceYBAInstallerUpdate(
 	}
 
 	for _, cmd := range commands {
-		m, err := runCommand(ctx, sshClient, cmd)
+		m, err := RunCommand(ctx, sshClient, cmd)
 		if err != nil {
 			tflog.Error(ctx, m)
 			if m != "" {
@@ -401,14 +403,14 @@ func resourceYBAInstallerDelete(
 		return diag.FromErr(err)
 	}
 
-	sshClient, err := newSSHClient(user, hostIPForSSH, *pk)
+	sshClient, err := NewSSHClient(user, hostIPForSSH, DefaultSSHPort, *pk)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 	defer sshClient.Close()
 
 	for _, cmd := range getDeleteCommands() {
-		m, err := runCommand(ctx, sshClient, cmd)
+		m, err := RunCommand(ctx, sshClient, cmd)
 		if err != nil {
 			tflog.Error(ctx, m)
 		}
@@ -21,6 +21,8 @@ import (
 	"fmt"
 	"net"
 	"os"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/bramvdbogaerde/go-scp"
@@ -30,7 +32,11 @@ import (
 	"golang.org/x/crypto/ssh"
 )
 
-func newSSHClient(user string, ip string, key string) (*ssh.Client, error) {
+// DefaultSSHPort is the port used for ssh commands unless configured otherwise
+const DefaultSSHPort = 22
+
+// NewSSHClient connects to the host with the private key
+func NewSSHClient(user string, ip string, port int, key string) (*ssh.Client, error) {
 	pk, err := ssh.ParsePrivateKey([]byte(key))
 	if err != nil {
 		return nil, err
@@ -42,18 +48,27 @@ func newSSHClient(user string, ip string, key string) (*ssh.Client, error) {
 			ssh.PublicKeys(pk),
 		},
 	}
-	c, err := ssh.Dial("tcp", net.JoinHostPort(ip, "22"), config)
+	c, err := ssh.Dial("tcp", net.JoinHostPort(ip, strconv.Itoa(port)), config)
 	if err != nil {
 		return nil, err
 	}
 	return c, nil
 }
 
-func runCommand(ctx context.Context, client *ssh.Client, cmd string) (string, error) {
+// RunCommand runs the command on the host and returns the contents of stderr
+func RunCommand(ctx context.Context, client *ssh.Client, cmd string) (string, error) {
+	_, stderr, err := RunCommandWithOutput(ctx, client, cmd)
+	return stderr, err
+}
+
+// RunCommandWithOutput runs the command on the host and returns the contents of stdout and
+// stderr
+func RunCommandWithOutput(ctx context.Context, client *ssh.Client, cmd string) (
+	string, string, error) {
 	tflog.Info(ctx, fmt.Sprintf("Running command: %s", cmd))
 	session, err := client.NewSession()
 	if err != nil {
-		return "", err
+		return "", "", err
 	}
 	var b bytes.Buffer
 	var c bytes.Buffer
@@ -62,11 +77,12 @@ func runCommand(ctx context.Context, client *ssh.Client, cmd string) (string, er
 	defer session.Close()
 	err = session.Run(cmd)
 	tflog.Info(ctx, b.String())
-	return c.String(), err
+	return b.String(), c.String(), err
 }
 
-func waitForIP(ctx context.Context, user string, ip string, pk string, timeout time.Duration) (
-	*ssh.Client, error) {
+// WaitForIP waits for the host to accept ssh connections
+func WaitForIP(ctx context.Context, user string, ip string, port int, pk string,
+	timeout time.Duration) (*ssh.Client, error) {
 	wait := &resource.StateChangeConf{
 		Delay:   1 * time.Second,
 		Pending: []string{"Waiting"},
@@ -75,7 +91,7 @@ func waitForIP(ctx context.Context, user string, ip string, pk string, timeout t
 
 		Refresh: func() (result interface{}, state string, err error) {
 			tflog.Info(ctx, fmt.Sprintf("Trying SSH connection to host using ip: %s", ip))
-			c, err := newSSHClient(user, ip, pk)
+			c, err := NewSSHClient(user, ip, port, pk)
 			if err != nil {
 				return nil, "Waiting", nil
 			}
@@ -91,7 +107,8 @@ func waitForIP(ctx context.Context, user string, ip string, pk string, timeout t
 	return c.(*ssh.Client), nil
 }
 
-func scpFile(ctx context.Context,
+// ScpFile copies the local file to the host
+func ScpFile(ctx context.Context,
 	sshClient *ssh.Client,
 	localFile string,
 	remoteFile string) error {
@@ -117,6 +134,28 @@ func scpFile(ctx context.Context,
 	return err
 }
 
+// ScpContent copies the content to the host under the remote filename with the permissions
+func ScpContent(ctx context.Context,
+	sshClient *ssh.Client,
+	content string,
+	remoteFile string,
+	permissions string) error {
+	tflog.Info(ctx, fmt.Sprintf("Copying content to remote host under filename %s", remoteFile))
+
+	c, err := scp.NewClientBySSH(sshClient)
+	if err != nil {
+		return err
+	}
+	defer c.Close()
+
+	err = c.Connect()
+	if err != nil {
+		return err
+	}
+
+	return c.CopyFile(context.Background(), strings.NewReader(content), remoteFile, permissions)
+}
+
 func waitForStart(ctx context.Context, c *client.APIClient, timeout time.Duration) error {
 	wait := &resource.StateChangeConf{
 		Delay:   1 * time.Second,
@@ -0,0 +1,197 @@
+// Licensed to YugabyteDB, Inc. under one or more contributor license
+// agreements. See the NOTICE file distributed with this work for
+// additional information regarding copyright ownership. Yugabyte
+// licenses this file to you under the Mozilla License, Version 2.0
+// (the "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+// http://mozilla.org/MPL/2.0/.
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package onprem
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/yugabyte/terraform-provider-yba/internal/api"
+	"github.com/yugabyte/terraform-provider-yba/internal/utils"
+)
+
+// InstanceTypes lists the instance types supported by a provider
+func InstanceTypes() *schema.Resource {
+	return &schema.Resource{
+		Description: "List the active instance types supported by a provider, " +
+			"optionally in a region.",
+
+		ReadContext: dataSourceInstanceTypesRead,
+
+		Schema: map[string]*schema.Schema{
+			"provider_uuid": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "UUID of the provider.",
+			},
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: "Region code. Only instance types available in the zones of " +
+					"the region are listed.",
+			},
+			"arch": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(
+					[]string{"x86_64", "aarch64"}, false)),
+				Description: "Only list instance types of this architecture. " +
+					"Permitted values: x86_64, aarch64.",
+			},
+			"instance_types": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Active instance types of the provider.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"instance_type_code": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Instance type code.",
+						},
+						"num_cores": {
+							Type:        schema.TypeFloat,
+							Computed:    true,
+							Description: "Number of cores per instance.",
+						},
+						"mem_size_gb": {
+							Type:        schema.TypeFloat,
+							Computed:    true,
+							Description: "Memory size in GB.",
+						},
+						"provider_code": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Provider code of instance type.",
+						},
+						"instance_type_details": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "Instance type details.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"arch": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Architecture of the instance type.",
+									},
+									"tenancy": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Tenancy.",
+									},
+									"volume_details_list": {
+										Type:        schema.TypeList,
+										Computed:    true,
+										Description: "Details of Volumes attached.",
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"mount_path": {
+													Type:        schema.TypeString,
+													Computed:    true,
+													Description: "Mount Path.",
+												},
+												"volume_size_gb": {
+													Type:        schema.TypeInt,
+													Computed:    true,
+													Description: "Volume Size in GB.",
+												},
+												"volume_type": {
+													Type:        schema.TypeString,
+													Computed:    true,
+													Description: "Volume Type.",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceInstanceTypesRead(
+	ctx context.Context,
+	d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	c := meta.(*api.APIClient).YugawareClient
+	cUUID := meta.(*api.APIClient).CustomerID
+	pUUID := d.Get("provider_uuid").(string)
+
+	req := c.InstanceTypesApi.ListOfInstanceType(ctx, cUUID, pUUID)
+	if arch := d.Get("arch").(string); len(arch) > 0 {
+		req = req.Arch(arch)
+	}
+	if regionCode := d.Get("region").(string); len(regionCode) > 0 {
+		providers, err := fetchProviderList(ctx, c, cUUID)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		p, err := findProvider(providers, pUUID)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		zones := make([]string, 0)
+		for _, r := range p.GetRegions() {
+			if r.GetCode() != regionCode {
+				continue
+			}
+			for _, z := range r.GetZones() {
+				zones = append(zones, z.GetCode())
+			}
+		}
+		if len(zones) == 0 {
+			return diag.FromErr(fmt.Errorf("No zones found in region %s of provider %s",
+				regionCode, pUUID))
+		}
+		req = req.Zone(zones)
+	}
+
+	r, response, err := req.Execute()
+	if err != nil {
+		errMessage := utils.ErrorFromHTTPResponse(response, err, utils.DataSourceEntity,
+			"Instance Types", "Read")
+		return diag.FromErr(errMessage)
+	}
+
+	instanceTypes := make([]map[string]interface{}, 0)
+	for _, i := range r {
+		if !i.GetActive() {
+			continue
+		}
+		instanceTypes = append(instanceTypes, map[string]interface{}{
+			"instance_type_code":    i.GetInstanceTypeCode(),
+			"num_cores":             i.GetNumCores(),
+			"mem_size_gb":           i.GetMemSizeGB(),
+			"provider_code":         i.GetProviderCode(),
+			"instance_type_details": flattenInstanceTypeDetails(i.GetInstanceTypeDetails()),
+		})
+	}
+	if err = d.Set("instance_types", instanceTypes); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(pUUID)
+	return diags
+}
@@ -0,0 +1,194 @@
+// Licensed to YugabyteDB, Inc. under one or more contributor license
+// agreements. See the NOTICE file distributed with this work for
+// additional information regarding copyright ownership. Yugabyte
+// licenses this file to you under the Mozilla License, Version 2.0
+// (the "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+// http://mozilla.org/MPL/2.0/.
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package onprem
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/yugabyte/terraform-provider-yba/internal/api"
+	"github.com/yugabyte/terraform-provider-yba/internal/utils"
+)
+
+// NodeAgents lists the node agents registered with YugabyteDB Anywhere
+func NodeAgents() *schema.Resource {
+	return &schema.Resource{
+		Description: "List the node agents registered with YugabyteDB Anywhere, optionally " +
+			"filtered by provider or node IP.",
+
+		ReadContext: dataSourceNodeAgentsRead,
+
+		Schema: map[string]*schema.Schema{
+			"provider_uuid": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only list the node agents of nodes of this provider.",
+			},
+			"ip": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only list the node agent of the node with this IP address.",
+			},
+			"node_agents": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Node agents.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"uuid": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "UUID of the node agent.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the node agent.",
+						},
+						"ip": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "IP address of the node.",
+						},
+						"port": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Port of the node agent server.",
+						},
+						"state": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "State of the node agent.",
+						},
+						"reachable": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Flag indicating if the node agent is reachable.",
+						},
+						"version": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Version of the node agent.",
+						},
+						"version_matched": {
+							Type:     schema.TypeBool,
+							Computed: true,
+							Description: "Flag indicating if the version of the node agent matches " +
+								"the version of YugabyteDB Anywhere.",
+						},
+						"arch_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Architecture of the node.",
+						},
+						"os_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Operating system of the node.",
+						},
+						"home": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Installation directory of the node agent.",
+						},
+						"provider_uuid": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "UUID of the provider of the node.",
+						},
+						"provider_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the provider of the node.",
+						},
+						"universe_uuid": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "UUID of the universe using the node.",
+						},
+						"universe_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the universe using the node.",
+						},
+						"updated_at": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Time of the last update of the node agent.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceNodeAgentsRead(
+	ctx context.Context,
+	d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	c := meta.(*api.APIClient).YugawareClient
+	cUUID := meta.(*api.APIClient).CustomerID
+	pUUID := d.Get("provider_uuid").(string)
+
+	req := c.NodeAgentsApi.ListNodeAgents(ctx, cUUID)
+	if ip := d.Get("ip").(string); len(ip) > 0 {
+		req = req.NodeIp(ip)
+	}
+	r, response, err := req.Execute()
+	if err != nil {
+		errMessage := utils.ErrorFromHTTPResponse(response, err, utils.DataSourceEntity,
+			"Node Agents", "Read")
+		return diag.FromErr(errMessage)
+	}
+
+	nodeAgents := make([]map[string]interface{}, 0)
+	for _, n := range r {
+		if len(pUUID) > 0 && n.GetProviderUuid() != pUUID {
+			continue
+		}
+		updatedAt := ""
+		if n.UpdatedAt != nil {
+			updatedAt = n.GetUpdatedAt().String()
+		}
+		nodeAgents = append(nodeAgents, map[string]interface{}{
+			"uuid":            n.GetUuid(),
+			"name":            n.GetName(),
+			"ip":              n.GetIp(),
+			"port":            n.GetPort(),
+			"state":           n.GetState(),
+			"reachable":       n.GetReachable(),
+			"version":         n.GetVersion(),
+			"version_matched": n.GetVersionMatched(),
+			"arch_type":       n.GetArchType(),
+			"os_type":         n.GetOsType(),
+			"home":            n.GetHome(),
+			"provider_uuid":   n.GetProviderUuid(),
+			"provider_name":   n.GetProviderName(),
+			"universe_uuid":   n.GetUniverseUuid(),
+			"universe_name":   n.GetUniverseName(),
+			"updated_at":      updatedAt,
+		})
+	}
+	if err = d.Set("node_agents", nodeAgents); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(cUUID)
+	return diags
+}
@@ -17,11 +17,18 @@ package onprem
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	client "github.com/yugabyte/platform-go-client"
 	"github.com/yugabyte/terraform-provider-yba/internal/api"
 	"github.com/yugabyte/terraform-provider-yba/internal/utils"
@@ -30,7 +37,8 @@ import (
 // PreflightCheck triggers preflight check for all nodes in an onprem provider
 func PreflightCheck() *schema.Resource {
 	return &schema.Resource{
-		Description: "Trigger pre-flight check for list of nodes of the onprem provider.",
+		Description: "Trigger pre-flight check for list of nodes of the onprem provider. " +
+			"Nodes are checked concurrently and the result of each node is reported.",
 
 		ReadContext: dataSourcePreflightCheckRead,
 
@@ -47,10 +55,241 @@ func PreflightCheck() *schema.Resource {
 				Description: "Preflight checks will be triggered for nodes listed. " +
 					"If empty, check is triggered for all nodes not in use in a universe.",
 			},
+			"parallelism": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Default:          5,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
+				Description:      "Maximum number of nodes checked concurrently. 5 by default.",
+			},
+			"fail_on_error": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+				Description: "Fail if the preflight check of any node fails. True by default. " +
+					"If false, failures are only reported in results.",
+			},
+			"passed": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Flag indicating if the preflight checks of all nodes passed.",
+			},
+			"results": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Preflight check results of each node.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ip": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "IP address of the node.",
+						},
+						"task_uuid": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "UUID of the preflight check task.",
+						},
+						"passed": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Flag indicating if the preflight check of the node passed.",
+						},
+						"error": {
+							Type:     schema.TypeString,
+							Computed: true,
+							Description: "Error of the preflight check of the node, including the " +
+								"messages of the failed checks.",
+						},
+						"checks": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Description: "Results of the individual checks run on the node, parsed " +
+								"from the output of the preflight check. YugabyteDB Anywhere only " +
+								"reports this output when the check fails, so the list is empty " +
+								"for nodes that passed.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Name of the check.",
+									},
+									"passed": {
+										Type:        schema.TypeBool,
+										Computed:    true,
+										Description: "Flag indicating if the check passed.",
+									},
+									"message": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Message or value reported by the check.",
+									},
+								},
+							},
+						},
+						"subtask_groups": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Description: "Subtask groups of the preflight check task. YugabyteDB " +
+								"Anywhere reports the state of each group, not of the individual " +
+								"checks run on the node.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Name of the subtask group.",
+									},
+									"state": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "State of the subtask group.",
+									},
+									"description": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Description of the subtask group.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 		},
 	}
 }
 
+// parsePreflightChecks extracts the results of the individual checks from the error of a failed
+// preflight subtask, which embeds the JSON output of the check script. The output maps each
+// check either to its result or to a validation result object.
+func parsePreflightChecks(message string) []map[string]interface{} {
+	checks := make([]map[string]interface{}, 0)
+	start := strings.Index(message, "{")
+	if start < 0 {
+		return checks
+	}
+	var output map[string]interface{}
+	if err := json.NewDecoder(strings.NewReader(message[start:])).Decode(&output); err != nil {
+		return checks
+	}
+	for name, v := range output {
+		check := map[string]interface{}{
+			"name":    name,
+			"passed":  false,
+			"message": "",
+		}
+		switch value := v.(type) {
+		case bool:
+			check["passed"] = value
+		case string:
+			if passed, err := strconv.ParseBool(value); err == nil {
+				check["passed"] = passed
+			} else {
+				check["message"] = value
+			}
+		case map[string]interface{}:
+			if valid, ok := value["valid"].(bool); ok {
+				check["passed"] = valid
+			}
+			if description, ok := value["description"].(string); ok {
+				check["message"] = description
+			}
+			if v, ok := value["value"]; ok && v != nil {
+				check["message"] = strings.TrimSpace(
+					fmt.Sprintf("%s %v", check["message"], v))
+			}
+		default:
+			check["message"] = fmt.Sprintf("%v", value)
+		}
+		checks = append(checks, check)
+	}
+	sort.Slice(checks, func(i, j int) bool {
+		return checks[i]["name"].(string) < checks[j]["name"].(string)
+	})
+	return checks
+}
+
+// preflightCheckNode runs the preflight check of a node and collects the results of the
+// individual checks, which are only reported by the failed subtasks, and the state of the
+// subtask groups of the task.
+func preflightCheckNode(ctx context.Context, c *client.APIClient, cUUID, pUUID,
+	nodeIP string, timeout time.Duration) map[string]interface{} {
+	result := map[string]interface{}{
+		"ip":             nodeIP,
+		"task_uuid":      "",
+		"passed":         false,
+		"error":          "",
+		"checks":         make([]map[string]interface{}, 0),
+		"subtask_groups": make([]map[string]interface{}, 0),
+	}
+	r, response, err := c.NodeInstancesApi.DetachedNodeAction(ctx, cUUID, pUUID, nodeIP).
+		NodeAction(
+			client.NodeActionFormData{
+				NodeAction: "PRECHECK_DETACHED",
+			}).Execute()
+	if err != nil {
+		errMessage := utils.ErrorFromHTTPResponse(response, err, utils.DataSourceEntity,
+			"Preflight Check", "Read")
+		result["error"] = errMessage.Error()
+		return result
+	}
+	if r.TaskUUID == nil {
+		result["passed"] = true
+		return result
+	}
+	tUUID := *r.TaskUUID
+	result["task_uuid"] = tUUID
+
+	tflog.Debug(ctx, fmt.Sprintf(
+		"Waiting for preflight check of node %s in on prem provider %s", nodeIP, pUUID))
+	waitErr := utils.WaitForTask(ctx, tUUID, cUUID, c, timeout)
+	result["passed"] = waitErr == nil
+
+	// the messages of failed checks are reported by the failed subtasks
+	if waitErr != nil {
+		result["error"] = waitErr.Error()
+		failed, _, err := c.CustomerTasksApi.ListFailedSubtasks(ctx, cUUID, tUUID).Execute()
+		if err == nil && len(failed.GetFailedSubTasks()) > 0 {
+			messages := make([]string, 0)
+			checks := make([]map[string]interface{}, 0)
+			for _, f := range failed.GetFailedSubTasks() {
+				messages = append(messages, f.GetErrorString())
+				checks = append(checks, parsePreflightChecks(f.GetErrorString())...)
+			}
+			result["error"] = strings.Join(messages, "; ")
+			result["checks"] = checks
+		}
+	}
+
+	status, response, err := c.CustomerTasksApi.TaskStatus(ctx, cUUID, tUUID).Execute()
+	if err != nil {
+		errMessage := utils.ErrorFromHTTPResponse(response, err, utils.DataSourceEntity,
+			"Preflight Check", "Get Task Status")
+		if waitErr == nil {
+			result["error"] = errMessage.Error()
+		}
+		return result
+	}
+	groups := make([]map[string]interface{}, 0)
+	details, _ := status["details"].(map[string]interface{})
+	taskDetails, _ := details["taskDetails"].([]interface{})
+	for _, t := range taskDetails {
+		task, _ := t.(map[string]interface{})
+		name, _ := task["title"].(string)
+		state, _ := task["state"].(string)
+		description, _ := task["description"].(string)
+		groups = append(groups, map[string]interface{}{
+			"name":        name,
+			"state":       state,
+			"description": description,
+		})
+	}
+	result["subtask_groups"] = groups
+	return result
+}
+
 func dataSourcePreflightCheckRead(
 	ctx context.Context,
 	d *schema.ResourceData,
@@ -79,27 +318,52 @@ func dataSourcePreflightCheckRead(
 			nodeList = append(nodeList, details.GetIp())
 		}
 	}
-	for _, nodeIP := range nodeList {
-		r, response, err := c.NodeInstancesApi.DetachedNodeAction(ctx, cUUID, pUUID, nodeIP).
-			NodeAction(
-				client.NodeActionFormData{
-					NodeAction: "PRECHECK_DETACHED",
-				}).Execute()
-		if err != nil {
-			errMessage := utils.ErrorFromHTTPResponse(response, err, utils.DataSourceEntity,
-				"Preflight Check", "Read")
-			return diag.FromErr(errMessage)
-		}
-		if r.TaskUUID != nil {
-			tflog.Debug(ctx, fmt.Sprintf(
-				"Waiting for preflight check of node %s in on prem provider %s", nodeIP, pUUID))
-			err = utils.WaitForTask(ctx, *r.TaskUUID, cUUID, c, d.Timeout(schema.TimeoutCreate))
-			if err != nil {
-				return diag.FromErr(err)
+
+	// nodes are checked concurrently by a bounded pool of workers
+	timeout := d.Timeout(schema.TimeoutRead)
+	results := make([]map[string]interface{}, len(nodeList))
+	nodeIndices := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < d.Get("parallelism").(int); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range nodeIndices {
+				results[i] = preflightCheckNode(ctx, c, cUUID, pUUID, nodeList[i], timeout)
+			}
+		}()
+	}
+	for i := range nodeList {
+		nodeIndices <- i
+	}
+	close(nodeIndices)
+	wg.Wait()
+
+	passed := true
+	for _, r := range results {
+		if !r["passed"].(bool) {
+			passed = false
+			tflog.Info(ctx, fmt.Sprintf("Preflight check of node %s failed: %s", r["ip"],
+				r["error"]))
+			if d.Get("fail_on_error").(bool) {
+				diags = append(diags, diag.Errorf("Preflight check of node %s failed: %s",
+					r["ip"], r["error"])...)
 			}
 		}
 	}
-	d.SetId("Success")
-	d.Set("nodes", nodeList)
+	if diags.HasError() {
+		return diags
+	}
+
+	d.SetId(pUUID)
+	if err := d.Set("nodes", nodeList); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("passed", passed); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("results", results); err != nil {
+		return diag.FromErr(err)
+	}
 	return diags
 }
@@ -0,0 +1,41 @@
+// Licensed to YugabyteDB, Inc. under one or more contributor license
+// agreements. See the NOTICE file distributed with this work for
+// additional information regarding copyright ownership. Yugabyte
+// licenses this file to you under the Mozilla License, Version 2.0
+// (the "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+// http://mozilla.org/MPL/2.0/.
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package onprem
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParsePreflightChecks(t *testing.T) {
+	message := "Failed preflight checks for node 10.0.0.1:\n" +
+		`{"ntp_service_status": true, "mount_points": "false", ` +
+		`"sudo_access": "sudo: a password is required", ` +
+		`"ulimit": {"valid": false, "description": "Open files limit", "value": 1024}}`
+
+	expected := []map[string]interface{}{
+		{"name": "mount_points", "passed": false, "message": ""},
+		{"name": "ntp_service_status", "passed": true, "message": ""},
+		{"name": "sudo_access", "passed": false, "message": "sudo: a password is required"},
+		{"name": "ulimit", "passed": false, "message": "Open files limit 1024"},
+	}
+	if got := parsePreflightChecks(message); !reflect.DeepEqual(got, expected) {
+		t.Errorf("parsePreflightChecks() = %v, expected %v", got, expected)
+	}
+	if got := parsePreflightChecks("Task timed out"); len(got) != 0 {
+		t.Errorf("parsePreflightChecks() = %v for a message without checks", got)
+	}
+}
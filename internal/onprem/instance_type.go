@@ -20,6 +20,7 @@ import (
 	"net/http"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	client "github.com/yugabyte/platform-go-client"
 	"github.com/yugabyte/terraform-provider-yba/internal/utils"
 	"golang.org/x/exp/slices"
@@ -81,6 +82,15 @@ func InstanceTypesSchema() *schema.Schema {
 					Description: "Instance Type Key.",
 					Elem: &schema.Resource{
 						Schema: map[string]*schema.Schema{
+							"arch": {
+								Type:     schema.TypeString,
+								Computed: true,
+								Optional: true,
+								ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(
+									[]string{"x86_64", "aarch64"}, false)),
+								Description: "Architecture of the instance type. " +
+									"Permitted values: x86_64, aarch64.",
+							},
 							"tenancy": {
 								Type:        schema.TypeString,
 								Computed:    true,
@@ -204,9 +214,16 @@ func buildInstanceTypeKey(id []interface{}, pUUID string) client.InstanceTypeKey
 
 func buildInstanceTypeDetails(details []interface{}) *client.InstanceTypeDetails {
 	typeDetails := details[0].(map[string]interface{})
-	return &client.InstanceTypeDetails{
+	res := client.InstanceTypeDetails{
 		VolumeDetailsList: buildVolumeDetails(typeDetails["volume_details_list"].([]interface{})),
 	}
+	if arch, ok := typeDetails["arch"].(string); ok && len(arch) > 0 {
+		res.SetArch(arch)
+	}
+	if tenancy, ok := typeDetails["tenancy"].(string); ok && len(tenancy) > 0 {
+		res.SetTenancy(tenancy)
+	}
+	return &res
 }
 
 func buildVolumeDetails(list []interface{}) *[]client.VolumeDetails {
@@ -216,7 +233,7 @@ func buildVolumeDetails(list []interface{}) *[]client.VolumeDetails {
 		vD := client.VolumeDetails{
 			MountPath:    details["mount_path"].(string),
 			VolumeSizeGB: int32(details["volume_size_gb"].(int)),
-			VolumeType:   "SSD",
+			VolumeType:   details["volume_type"].(string),
 		}
 		volumeDetailsList = append(volumeDetailsList, vD)
 	}
@@ -275,6 +292,7 @@ func flattenInstanceTypes(instanceTypeList []client.InstanceTypeResp, order []st
 func flattenInstanceTypeDetails(instanceTypeDetails client.InstanceTypeDetails) (
 	res []map[string]interface{}) {
 	i := map[string]interface{}{
+		"arch":                instanceTypeDetails.GetArch(),
 		"tenancy":             instanceTypeDetails.GetTenancy(),
 		"volume_details_list": flattenVolumeDetails(instanceTypeDetails.GetVolumeDetailsList()),
 	}
@@ -0,0 +1,144 @@
+// Licensed to YugabyteDB, Inc. under one or more contributor license
+// agreements. See the NOTICE file distributed with this work for
+// additional information regarding copyright ownership. Yugabyte
+// licenses this file to you under the Mozilla License, Version 2.0
+// (the "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+// http://mozilla.org/MPL/2.0/.
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package onprem
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"path"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	client "github.com/yugabyte/platform-go-client"
+	"github.com/yugabyte/terraform-provider-yba/internal/api"
+	"github.com/yugabyte/terraform-provider-yba/internal/installation"
+	"github.com/yugabyte/terraform-provider-yba/internal/utils"
+	"golang.org/x/crypto/ssh"
+)
+
+// runNodeAgentInstaller downloads the node agent installer from YugabyteDB Anywhere, copies it
+// to the node and runs it with the command (install, upgrade). The installer and the API token
+// are copied to a private temporary directory created with mktemp on the node, and the token is
+// read from there by the shell running the installer to keep it out of the logs and the process
+// list. The certificate of YugabyteDB Anywhere is verified by the installer unless skipVerifyCert is set.
+func runNodeAgentInstaller(ctx context.Context, apiClient *api.APIClient,
+	sshClient *ssh.Client, command, arch, args string, skipVerifyCert bool) error {
+	c := apiClient.YugawareClient
+
+	installer, response, err := c.NodeAgentsApi.DownloadNodeAgentInstaller(ctx).DownloadType(
+		"installer").Os("linux").Arch(arch).Execute()
+	if err != nil {
+		errMessage := utils.ErrorFromHTTPResponse(response, err, utils.ResourceEntity,
+			"Node Agent", "Download Installer")
+		return errMessage
+	}
+
+	// the directory is only accessible by the SSH user
+	dir, m, err := installation.RunCommandWithOutput(ctx, sshClient,
+		"umask 077 && mktemp -d /tmp/node-agent.XXXXXX")
+	if err != nil {
+		tflog.Error(ctx, m)
+		return fmt.Errorf("Failed creating temporary directory on node: %s", m)
+	}
+	dir = strings.TrimSpace(dir)
+	installerFile := path.Join(dir, "node-agent-installer.sh")
+	tokenFile := path.Join(dir, "node-agent-api-token")
+
+	// the directory is removed from the node even if the installer fails
+	defer func() {
+		cleanup := fmt.Sprintf("rm -rf %s", dir)
+		if cm, cErr := installation.RunCommand(ctx, sshClient, cleanup); cErr != nil {
+			tflog.Warn(ctx, fmt.Sprintf("Failed removing node agent installer files: %s", cm))
+		}
+	}()
+
+	err = installation.ScpContent(ctx, sshClient, installer, installerFile, "0700")
+	if err != nil {
+		return err
+	}
+	err = installation.ScpContent(ctx, sshClient, apiClient.APIKey, tokenFile, "0600")
+	if err != nil {
+		return err
+	}
+
+	if skipVerifyCert {
+		args = strings.TrimSpace("--skip_verify_cert " + args)
+	}
+	// the arguments are set with the set builtin and the installer is sourced by the same
+	// shell, so the token read from the file is not in the arguments of any process
+	script := strings.TrimSpace(fmt.Sprintf("set -- -c %s -u %s -t \"$(cat %s)\" %s",
+		command, apiClient.VanillaClient.BaseURL(), tokenFile, args))
+	cmd := fmt.Sprintf("sudo bash -c %s %s", shellQuote(script+" && . "+installerFile),
+		installerFile)
+	m, err = installation.RunCommand(ctx, sshClient, cmd)
+	if err != nil {
+		tflog.Error(ctx, m)
+		if m != "" {
+			return errors.New(m)
+		}
+		return fmt.Errorf("Node agent %s failed, please run with TF_LOG=INFO for "+
+			"error logs", command)
+	}
+	return nil
+}
+
+// shellQuote quotes s as a single argument of a POSIX shell
+func shellQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
+
+// errNodeAgentsUnsupported is returned by nodeAgentByIP when YugabyteDB Anywhere does not serve
+// the node agent API, as on versions released before node agents
+var errNodeAgentsUnsupported = errors.New(
+	"Node agents are not supported by this version of YugabyteDB Anywhere")
+
+// nodeAgentByIP returns the node agent running on the node, nil if there is none
+func nodeAgentByIP(ctx context.Context, c *client.APIClient, cUUID, ip string) (
+	*client.NodeAgentResp, error) {
+	r, response, err := c.NodeAgentsApi.ListNodeAgents(ctx, cUUID).NodeIp(ip).Execute()
+	if err != nil {
+		if response != nil && response.StatusCode == http.StatusNotFound {
+			return nil, errNodeAgentsUnsupported
+		}
+		errMessage := utils.ErrorFromHTTPResponse(response, err, utils.ResourceEntity,
+			"Node Agent", "Read")
+		return nil, errMessage
+	}
+	for i, n := range r {
+		if n.GetIp() == ip {
+			return &r[i], nil
+		}
+	}
+	return nil, nil
+}
+
+// reinstallUniverseNodeAgent reinstalls the node agent of a universe node through
+// YugabyteDB Anywhere, which connects to the node with the access key of the provider
+func reinstallUniverseNodeAgent(ctx context.Context, c *client.APIClient, cUUID, uUUID,
+	nodeName string) error {
+	_, response, err := c.NodeAgentsApi.ReinstallNodeAgent(ctx, cUUID, uUUID).
+		ReinstallNodeAgentForm(client.ReinstallNodeAgentForm{
+			NodeNames: &[]string{nodeName},
+		}).Execute()
+	if err != nil {
+		errMessage := utils.ErrorFromHTTPResponse(response, err, utils.ResourceEntity,
+			"Node Agent", "Reinstall")
+		return errMessage
+	}
+	return nil
+}
@@ -18,6 +18,9 @@ package onprem
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	client "github.com/yugabyte/platform-go-client"
@@ -187,6 +190,26 @@ func nodeInstanceGet(ctx context.Context, c *client.APIClient, cUUID, nUUID stri
 	return r, nil
 }
 
+// nodeInstanceGetIfExists returns the node instance, nil if it has been removed from the provider
+func nodeInstanceGetIfExists(ctx context.Context, c *client.APIClient, cUUID, nUUID string) (
+	*client.NodeInstance, error) {
+	r, response, err := c.NodeInstancesApi.GetNodeInstance(ctx, cUUID, nUUID).Execute()
+	if err != nil {
+		if response != nil && response.StatusCode == http.StatusNotFound {
+			return nil, nil
+		}
+		errMessage := utils.ErrorFromHTTPResponse(response, err, utils.ResourceEntity,
+			"Onprem Node Instance", "Get")
+		// removed node instances are reported as invalid
+		if response != nil && response.StatusCode == http.StatusBadRequest &&
+			strings.Contains(errMessage.Error(), "Invalid node UUID") {
+			return nil, nil
+		}
+		return nil, errMessage
+	}
+	return &r, nil
+}
+
 func nodeInstanceDelete(ctx context.Context, c *client.APIClient, cUUID, pUUID,
 	nodeIP string) error {
 	_, response, err := c.NodeInstancesApi.DeleteInstance(ctx, cUUID, pUUID,
@@ -199,6 +222,25 @@ func nodeInstanceDelete(ctx context.Context, c *client.APIClient, cUUID, pUUID,
 	return nil
 }
 
+// nodeInstanceUpdateFields are the node instance attributes YugabyteDB Anywhere cannot edit,
+// changing them requires removing the node from the provider and adding it again
+var nodeInstanceUpdateFields = []string{"instance_name", "instance_type", "node_configs",
+	"region", "zone", "ssh_user"}
+
+// nodeInstanceChanged checks if the node instance needs to be added to the provider again
+func nodeInstanceChanged(old, new map[string]interface{}) bool {
+	for _, k := range nodeInstanceUpdateFields {
+		if k == "ssh_user" && new[k].(string) == "" {
+			// ssh user is set by YugabyteDB Anywhere if not provided
+			continue
+		}
+		if !reflect.DeepEqual(old[k], new[k]) {
+			return true
+		}
+	}
+	return false
+}
+
 func buildNodeInstanceFormData(ni interface{}) map[string](map[string]client.NodeInstanceFormData) {
 	nodeInstances := ni.([]interface{})
 	nodes := make(map[string](map[string]([]client.NodeInstanceData)))
@@ -210,7 +252,7 @@ func buildNodeInstanceFormData(ni interface{}) map[string](map[string]client.Nod
 			InstanceName: nodeInstance["instance_name"].(string),
 			InstanceType: nodeInstance["instance_type"].(string),
 			Ip:           nodeInstance["ip"].(string),
-			NodeConfigs:  buildNodeConfig(nodeInstance["node_config"]),
+			NodeConfigs:  buildNodeConfig(nodeInstance["node_configs"]),
 			NodeName:     utils.GetStringPointer(nodeInstance["node_name"].(string)),
 			Region:       nodeInstance["region"].(string),
 			Zone:         nodeInstance["zone"].(string),
@@ -23,7 +23,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	client "github.com/yugabyte/platform-go-client"
 	"github.com/yugabyte/terraform-provider-yba/internal/utils"
-	"golang.org/x/exp/slices"
 )
 
 // RegionsSchema manages Region level information of cloud providers
@@ -211,74 +210,3 @@ func fetchZoneUUIDFromZoneName(ctx context.Context, c *client.APIClient,
 	return "", fmt.Errorf("No availability zone %s found in region "+
 		" %s of provider %s", azName, rUUID, pUUID)
 }
-
-func createRequestForEditRegions(old, new []client.Region) (req []client.Region) {
-	newRegionNamesList := make([]string, 0)
-	for _, n := range new {
-		newRegionNamesList = append(newRegionNamesList, n.GetName())
-	}
-	// length old == new --> old stays the same, completely run over, new = 0
-	// length old < new --> old stays the same, new will have a few elements left --> add to req
-	// length old > new --> new will run out, old will remain --> delete from provider, set to false
-
-	for _, o := range old {
-		index := slices.Index(newRegionNamesList, o.GetName())
-		if index == -1 {
-			o.SetActive(false)
-			req = append(req, o)
-		} else {
-			n := new[index]
-			r := buildRegionForEditProvider(o, n)
-			req = append(req, r)
-			new = append(new[:index], new[index+1:]...)
-			newRegionNamesList = append(newRegionNamesList[:index], newRegionNamesList[index+1:]...)
-		}
-	}
-	if len(new) > 0 {
-		for _, n := range new {
-			req = append(req, n)
-		}
-	}
-	return req
-}
-
-func buildRegionForEditProvider(old, new client.Region) client.Region {
-	new.SetUuid(old.GetUuid())
-	new.SetActive(old.GetActive())
-	new.SetCode(new.GetName())
-	oldZones := old.GetZones()
-	newZones := new.GetZones()
-	newAZNamesList := make([]string, 0)
-	for _, n := range newZones {
-		newAZNamesList = append(newAZNamesList, n.GetName())
-	}
-	reqZones := make([]client.AvailabilityZone, 0)
-	for _, o := range oldZones {
-		index := slices.Index(newAZNamesList, o.GetName())
-		if index == -1 {
-			o.SetActive(false)
-			reqZones = append(reqZones, o)
-		} else {
-			n := newZones[index]
-			r := buildAZForEditProvider(o, n)
-			reqZones = append(reqZones, r)
-			newZones = append(newZones[:index], newZones[index+1:]...)
-			newAZNamesList = append(newAZNamesList[:index], newAZNamesList[index+1:]...)
-		}
-	}
-	if len(newZones) > 0 {
-		for _, n := range newZones {
-			reqZones = append(reqZones, n)
-		}
-	}
-
-	new.SetZones(reqZones)
-	return new
-}
-
-func buildAZForEditProvider(oz, nz client.AvailabilityZone) client.AvailabilityZone {
-	nz.SetCode(nz.GetCode())
-	nz.SetUuid(oz.GetUuid())
-	nz.SetActive(oz.GetActive())
-	return nz
-}
@@ -0,0 +1,201 @@
+// Licensed to YugabyteDB, Inc. under one or more contributor license
+// agreements. See the NOTICE file distributed with this work for
+// additional information regarding copyright ownership. Yugabyte
+// licenses this file to you under the Mozilla License, Version 2.0
+// (the "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+// http://mozilla.org/MPL/2.0/.
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package onprem
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	client "github.com/yugabyte/platform-go-client"
+	"github.com/yugabyte/terraform-provider-yba/internal/api"
+	"github.com/yugabyte/terraform-provider-yba/internal/utils"
+)
+
+// ResourceInstanceType creates and maintains a single instance type of any provider
+func ResourceInstanceType() *schema.Resource {
+	instanceTypeSchema := InstanceTypesSchema().Elem.(*schema.Resource).Schema
+	return &schema.Resource{
+		Description: "Instance type of a provider. Registers instance types missing from the " +
+			"YugabyteDB Anywhere catalog of AWS, GCP and Azure providers, or the instance " +
+			"types of on-premises providers.",
+
+		CreateContext: resourceInstanceTypeCreate,
+		ReadContext:   resourceInstanceTypeRead,
+		UpdateContext: resourceInstanceTypeUpdate,
+		DeleteContext: resourceInstanceTypeDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceInstanceTypeImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"provider_uuid": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "UUID of the provider the instance type belongs to.",
+			},
+			"instance_type_code": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Instance type code, as known to the cloud provider.",
+			},
+			"instance_type_details": instanceTypeSchema["instance_type_details"],
+			"mem_size_gb":           instanceTypeSchema["mem_size_gb"],
+			"num_cores":             instanceTypeSchema["num_cores"],
+			"active":                instanceTypeSchema["active"],
+			"provider_code":         instanceTypeSchema["provider_code"],
+		},
+	}
+}
+
+func buildInstanceTypeFromResource(d *schema.ResourceData) client.InstanceType {
+	instanceType := map[string]interface{}{
+		"instance_type_key": []interface{}{
+			map[string]interface{}{
+				"instance_type_code": d.Get("instance_type_code").(string),
+			},
+		},
+		"instance_type_details": d.Get("instance_type_details").([]interface{}),
+		"mem_size_gb":           d.Get("mem_size_gb").(float64),
+		"num_cores":             d.Get("num_cores").(float64),
+	}
+	return buildInstanceType(instanceType, d.Get("provider_uuid").(string))
+}
+
+func resourceInstanceTypeCreate(
+	ctx context.Context,
+	d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	c := meta.(*api.APIClient).YugawareClient
+	cUUID := meta.(*api.APIClient).CustomerID
+	pUUID := d.Get("provider_uuid").(string)
+
+	req := buildInstanceTypeFromResource(d)
+	r, response, err := c.InstanceTypesApi.CreateInstanceType(
+		ctx, cUUID, pUUID).InstanceType(req).Execute()
+	if err != nil {
+		errMessage := utils.ErrorFromHTTPResponse(response, err, utils.ResourceEntity,
+			"Instance Type", "Create")
+		return diag.FromErr(errMessage)
+	}
+	d.SetId(fmt.Sprintf("%s/%s", pUUID, r.GetInstanceTypeCode()))
+	return resourceInstanceTypeRead(ctx, d, meta)
+}
+
+func resourceInstanceTypeRead(
+	ctx context.Context,
+	d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := meta.(*api.APIClient).YugawareClient
+	cUUID := meta.(*api.APIClient).CustomerID
+	pUUID := d.Get("provider_uuid").(string)
+	code := d.Get("instance_type_code").(string)
+
+	r, response, err := c.InstanceTypesApi.InstanceTypeDetail(ctx, cUUID, pUUID, code).Execute()
+	if err != nil {
+		errMessage := utils.ErrorFromHTTPResponse(response, err, utils.ResourceEntity,
+			"Instance Type", "Read")
+		return diag.FromErr(errMessage)
+	}
+
+	if !r.GetActive() {
+		// deleted instance types are only marked inactive
+		tflog.Info(ctx, fmt.Sprintf("Instance type %s is inactive, removing from state", d.Id()))
+		d.SetId("")
+		return diags
+	}
+
+	if err = d.Set("instance_type_details",
+		flattenInstanceTypeDetails(r.GetInstanceTypeDetails())); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("mem_size_gb", r.GetMemSizeGB()); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("num_cores", r.GetNumCores()); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("active", r.GetActive()); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("provider_code", r.GetProviderCode()); err != nil {
+		return diag.FromErr(err)
+	}
+	return diags
+}
+
+func resourceInstanceTypeUpdate(
+	ctx context.Context,
+	d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	c := meta.(*api.APIClient).YugawareClient
+	cUUID := meta.(*api.APIClient).CustomerID
+	pUUID := d.Get("provider_uuid").(string)
+
+	// YugabyteDB Anywhere updates existing instance types on create
+	req := buildInstanceTypeFromResource(d)
+	_, response, err := c.InstanceTypesApi.CreateInstanceType(
+		ctx, cUUID, pUUID).InstanceType(req).Execute()
+	if err != nil {
+		errMessage := utils.ErrorFromHTTPResponse(response, err, utils.ResourceEntity,
+			"Instance Type", "Update")
+		return diag.FromErr(errMessage)
+	}
+	return resourceInstanceTypeRead(ctx, d, meta)
+}
+
+func resourceInstanceTypeDelete(
+	ctx context.Context,
+	d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := meta.(*api.APIClient).YugawareClient
+	cUUID := meta.(*api.APIClient).CustomerID
+
+	err := instanceTypeDelete(ctx, c, cUUID, d.Get("provider_uuid").(string),
+		d.Get("instance_type_code").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return diags
+}
+
+func resourceInstanceTypeImport(
+	ctx context.Context,
+	d *schema.ResourceData,
+	meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("Instance type import ID must be of the form " +
+			"<provider-uuid>/<instance-type-code>")
+	}
+	if err := d.Set("provider_uuid", parts[0]); err != nil {
+		return nil, err
+	}
+	if err := d.Set("instance_type_code", parts[1]); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
@@ -17,15 +17,19 @@ package onprem
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	client "github.com/yugabyte/platform-go-client"
 	"github.com/yugabyte/terraform-provider-yba/internal/api"
+	"github.com/yugabyte/terraform-provider-yba/internal/installation"
 	"github.com/yugabyte/terraform-provider-yba/internal/utils"
 	"golang.org/x/exp/slices"
 )
@@ -48,6 +52,7 @@ func ResourceOnPremNodeInstances() *schema.Resource {
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
 			Delete: schema.DefaultTimeout(5 * time.Minute),
 		},
 
@@ -118,6 +123,7 @@ func ResourceOnPremNodeInstances() *schema.Resource {
 			"node_configs": {
 				Type:        schema.TypeList,
 				Optional:    true,
+				ForceNew:    true,
 				Description: "Node Configurations.",
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
@@ -157,6 +163,7 @@ func ResourceOnPremNodeInstances() *schema.Resource {
 				Type:        schema.TypeString,
 				Optional:    true,
 				Computed:    true,
+				ForceNew:    true,
 				Description: "SSH user.",
 			},
 			"details_json": {
@@ -170,49 +177,254 @@ func ResourceOnPremNodeInstances() *schema.Resource {
 				Computed:    true,
 				Description: "Is the node used in a universe.",
 			},
+			"node_agent": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Description: "Manage the node agent of the node. The node agent is installed " +
+					"over SSH if it is not running on the node when the block is added.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ssh_host_ip": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Description: "IP address of the node for SSH. Typically same as ip, " +
+								"which is used if not set.",
+						},
+						"ssh_user": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Description: "User to use for ssh commands. Must have sudo " +
+								"privileges. The SSH user of the node is used if not set.",
+						},
+						"ssh_port": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Default:     installation.DefaultSSHPort,
+							Description: "Port to use for ssh commands. 22 by default.",
+						},
+						"ssh_private_key_file_path": {
+							Type:     schema.TypeString,
+							Required: true,
+							Description: "Path to the file containing the private key to use " +
+								"for ssh commands.",
+						},
+						"arch": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "amd64",
+							ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(
+								[]string{"amd64", "arm64"}, false)),
+							Description: "Architecture of the node for the node agent installer. " +
+								"Permitted values: amd64, arm64. amd64 by default.",
+						},
+						"installer_args": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Additional arguments passed to the node agent installer.",
+						},
+						"skip_verify_cert": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+							Description: "Skip the verification of the certificate of " +
+								"YugabyteDB Anywhere by the node agent installer. Only set for " +
+								"YugabyteDB Anywhere with a self-signed certificate. False " +
+								"by default.",
+						},
+						"reinstall_trigger": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Description: "Arbitrary value, changing it reinstalls the node agent. " +
+								"The node agent of a node in use by a universe is reinstalled by " +
+								"YugabyteDB Anywhere.",
+						},
+						"upgrade": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Description: "Upgrade the node agent when its version does not match " +
+								"the version of YugabyteDB Anywhere.",
+						},
+					},
+				},
+			},
+			"node_agent_state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "State of the node agent of the node.",
+			},
+			"node_agent_version": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Version of the node agent of the node.",
+			},
+			"node_agent_version_matched": {
+				Type:     schema.TypeBool,
+				Computed: true,
+				Description: "Flag indicating if the version of the node agent matches the " +
+					"version of YugabyteDB Anywhere.",
+			},
 		},
 	}
 }
 
 func resourceOnPremNodeDiff() schema.CustomizeDiffFunc {
-	return customdiff.All(customdiff.IfValueChange("ip",
-		func(ctx context.Context, old, new, meta interface{}) bool {
-			return strings.Compare(old.(string), new.(string)) != 0 && old.(string) != ""
-		},
-		func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
-			// if node is in use, restrict removal
-			c := meta.(*api.APIClient).YugawareClient
-			cUUID := meta.(*api.APIClient).CustomerID
-			pUUID := d.Get("provider_uuid").(string)
-			pName := d.Get("provider_name").(string)
-
-			pUUID, pName, err := fetchProviderUUIDAndName(ctx, c, cUUID, pUUID, pName)
-			if err != nil {
-				return err
-			}
-
-			ip := d.Get("ip").(string)
-			existingNodeInstances, err := nodeInstancesRead(ctx, c, cUUID, pUUID)
-			if err != nil {
-				return err
-			}
-			inUseNodes := make([]string, 0)
-			for _, n := range existingNodeInstances {
-				if n.GetInUse() {
-					details := n.GetDetails()
-					ip := details.GetIp()
-					inUseNodes = append(inUseNodes, ip)
+	return customdiff.All(
+		customdiff.IfValueChange("ip",
+			func(ctx context.Context, old, new, meta interface{}) bool {
+				return strings.Compare(old.(string), new.(string)) != 0 && old.(string) != ""
+			},
+			func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+				// if node is in use, restrict removal
+				c := meta.(*api.APIClient).YugawareClient
+				cUUID := meta.(*api.APIClient).CustomerID
+				pUUID := d.Get("provider_uuid").(string)
+				pName := d.Get("provider_name").(string)
+
+				pUUID, pName, err := fetchProviderUUIDAndName(ctx, c, cUUID, pUUID, pName)
+				if err != nil {
+					return err
+				}
+
+				ip := d.Get("ip").(string)
+				existingNodeInstances, err := nodeInstancesRead(ctx, c, cUUID, pUUID)
+				if err != nil {
+					return err
+				}
+				inUseNodes := make([]string, 0)
+				for _, n := range existingNodeInstances {
+					if n.GetInUse() {
+						details := n.GetDetails()
+						ip := details.GetIp()
+						inUseNodes = append(inUseNodes, ip)
 
+					}
 				}
-			}
-			if len(inUseNodes) > 0 {
-				if !slices.Contains(inUseNodes, ip) {
-					return fmt.Errorf("Unable to remove in-use node: %v", ip)
+				if len(inUseNodes) > 0 {
+					if !slices.Contains(inUseNodes, ip) {
+						return fmt.Errorf("Unable to remove in-use node: %v", ip)
+					}
 				}
-			}
-			return nil
-		},
-	))
+				return nil
+			},
+		),
+		customdiff.If(
+			func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) bool {
+				if d.Id() == "" {
+					return false
+				}
+				for _, k := range nodeInstanceUpdateFields {
+					if d.HasChange(k) {
+						return true
+					}
+				}
+				return false
+			},
+			func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+				// if node is in use, restrict replacement
+				c := meta.(*api.APIClient).YugawareClient
+				cUUID := meta.(*api.APIClient).CustomerID
+				node, err := nodeInstanceGet(ctx, c, cUUID, d.Id())
+				if err != nil {
+					return err
+				}
+				if node.GetInUse() {
+					return fmt.Errorf("Unable to replace in use node: %v", d.Get("ip").(string))
+				}
+				return nil
+			},
+		),
+		customdiff.If(
+			func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) bool {
+				settings := d.Get("node_agent").([]interface{})
+				if d.Id() == "" || len(settings) == 0 || settings[0] == nil {
+					return false
+				}
+				agent := utils.MapFromSingletonList(settings)
+				return agent["upgrade"].(bool) && d.Get("node_agent_version").(string) != "" &&
+					!d.Get("node_agent_version_matched").(bool)
+			},
+			func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+				// the node agent is upgraded during the update
+				return d.SetNewComputed("node_agent_version")
+			},
+		),
+	)
+}
+
+// runNodeAgentCommand runs the node agent installer on the node over SSH
+func runNodeAgentCommand(ctx context.Context, d *schema.ResourceData, meta interface{},
+	command string, timeout time.Duration) error {
+	agent := utils.MapFromSingletonList(d.Get("node_agent").([]interface{}))
+	hostIPForSSH := agent["ssh_host_ip"].(string)
+	if hostIPForSSH == "" {
+		hostIPForSSH = d.Get("ip").(string)
+	}
+	user := agent["ssh_user"].(string)
+	if user == "" {
+		user = d.Get("ssh_user").(string)
+	}
+	if user == "" {
+		return fmt.Errorf("SSH user is required to %s the node agent of node %s", command,
+			d.Get("ip").(string))
+	}
+	pk, err := utils.ReadSSHPrivateKey(agent["ssh_private_key_file_path"].(string))
+	if err != nil {
+		return err
+	}
+
+	sshClient, err := installation.WaitForIP(ctx, user, hostIPForSSH, agent["ssh_port"].(int),
+		*pk, timeout)
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Timeout: Couldn't connect to host %s", hostIPForSSH))
+		return err
+	}
+	defer sshClient.Close()
+
+	tflog.Info(ctx, fmt.Sprintf("Running node agent %s on node %s", command, hostIPForSSH))
+	return runNodeAgentInstaller(ctx, meta.(*api.APIClient), sshClient, command,
+		agent["arch"].(string), agent["installer_args"].(string),
+		agent["skip_verify_cert"].(bool))
+}
+
+// updateNodeAgent installs, reinstalls or upgrades the node agent of the node
+func updateNodeAgent(ctx context.Context, d *schema.ResourceData, meta interface{},
+	timeout time.Duration) error {
+	c := meta.(*api.APIClient).YugawareClient
+	cUUID := meta.(*api.APIClient).CustomerID
+
+	settings := d.Get("node_agent").([]interface{})
+	if len(settings) == 0 || settings[0] == nil {
+		return nil
+	}
+	agent := utils.MapFromSingletonList(settings)
+
+	nodeAgent, err := nodeAgentByIP(ctx, c, cUUID, d.Get("ip").(string))
+	if err != nil {
+		return err
+	}
+	if nodeAgent == nil {
+		return runNodeAgentCommand(ctx, d, meta, "install", timeout)
+	}
+
+	if d.HasChange("node_agent.0.reinstall_trigger") {
+		node, err := nodeInstanceGet(ctx, c, cUUID, d.Id())
+		if err != nil {
+			return err
+		}
+		if node.GetInUse() && nodeAgent.GetUniverseUuid() != "" {
+			tflog.Info(ctx, fmt.Sprintf("Reinstalling node agent on node %s of universe %s",
+				node.GetNodeName(), nodeAgent.GetUniverseUuid()))
+			return reinstallUniverseNodeAgent(ctx, c, cUUID, nodeAgent.GetUniverseUuid(),
+				node.GetNodeName())
+		}
+		return runNodeAgentCommand(ctx, d, meta, "install", timeout)
+	}
+
+	if agent["upgrade"].(bool) && !nodeAgent.GetVersionMatched() {
+		return runNodeAgentCommand(ctx, d, meta, "upgrade", timeout)
+	}
+	return nil
 }
 
 func findProviderByName(providers []client.Provider, name string) (*client.Provider, error) {
@@ -262,6 +474,20 @@ func fetchProviderUUIDAndName(ctx context.Context, c *client.APIClient, cUUID, p
 	return pUUID, pName, nil
 }
 
+func buildNodeInstanceFromResource(d *schema.ResourceData) map[string]interface{} {
+	return map[string]interface{}{
+		"instance_name": d.Get("instance_name").(string),
+		"instance_type": d.Get("instance_type").(string),
+		"ip":            d.Get("ip").(string),
+		"node_name":     d.Get("node_name").(string),
+		"node_configs":  d.Get("node_configs").([]interface{}),
+		"region":        d.Get("region").(string),
+		"zone":          d.Get("zone").(string),
+		"ssh_user":      d.Get("ssh_user").(string),
+		"in_use":        d.Get("in_use").(bool),
+	}
+}
+
 func resourceOnPremNodeCreate(
 	ctx context.Context,
 	d *schema.ResourceData,
@@ -287,29 +513,7 @@ func resourceOnPremNodeCreate(
 		return diag.FromErr(err)
 	}
 
-	nodeConfigs := make([]map[string]interface{}, 0)
-	for _, nc := range d.Get("node_configs").([]interface{}) {
-		n := nc.(map[string]interface{})
-		i := map[string]interface{}{
-			"type":  n["type"],
-			"value": n["value"],
-		}
-		nodeConfigs = append(nodeConfigs, i)
-	}
-
-	nodes := make([]interface{}, 0)
-	i := map[string]interface{}{
-		"instance_name": d.Get("instance_name").(string),
-		"instance_type": d.Get("instance_type").(string),
-		"ip":            d.Get("ip").(string),
-		"node_name":     d.Get("node_name").(string),
-		"node_configs":  nodeConfigs,
-		"region":        d.Get("region").(string),
-		"zone":          d.Get("zone").(string),
-		"ssh_user":      d.Get("ssh_user").(string),
-		"in_use":        d.Get("in_use").(bool),
-	}
-	nodes = append(nodes, i)
+	nodes := []interface{}{buildNodeInstanceFromResource(d)}
 
 	nodeListReturned, err := nodeInstancesCreate(ctx, c, cUUID, pUUID, nodes)
 	if err != nil {
@@ -320,6 +524,11 @@ func resourceOnPremNodeCreate(
 		d.SetId(n["node_uuid"].(string))
 	}
 
+	err = updateNodeAgent(ctx, d, meta, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	return resourceOnPremNodeRead(ctx, d, meta)
 
 }
@@ -399,6 +608,33 @@ func resourceOnPremNodeRead(
 		return diag.FromErr(err)
 	}
 
+	nodeAgent, err := nodeAgentByIP(ctx, c, cUUID, details.GetIp())
+	if errors.Is(err, errNodeAgentsUnsupported) {
+		tflog.Warn(ctx, fmt.Sprintf("Unable to read node agent of node %s: %s",
+			details.GetIp(), err.Error()))
+	} else if err != nil {
+		return diag.FromErr(err)
+	}
+	var nodeAgentState, nodeAgentVersion string
+	nodeAgentVersionMatched := false
+	if nodeAgent != nil {
+		nodeAgentState = nodeAgent.GetState()
+		nodeAgentVersion = nodeAgent.GetVersion()
+		nodeAgentVersionMatched = nodeAgent.GetVersionMatched()
+	}
+	err = d.Set("node_agent_state", nodeAgentState)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = d.Set("node_agent_version", nodeAgentVersion)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = d.Set("node_agent_version_matched", nodeAgentVersionMatched)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	pUUID := d.Get("provider_uuid").(string)
 	pName := d.Get("provider_name").(string)
 
@@ -423,7 +659,12 @@ func resourceOnPremNodeUpdate(
 	ctx context.Context,
 	d *schema.ResourceData,
 	meta interface{}) diag.Diagnostics {
-	return diag.Diagnostics{}
+	err := updateNodeAgent(ctx, d, meta, d.Timeout(schema.TimeoutUpdate))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceOnPremNodeRead(ctx, d, meta)
 }
 
 func resourceOnPremNodeDelete(
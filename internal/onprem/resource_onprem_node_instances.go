@@ -0,0 +1,318 @@
+// Licensed to YugabyteDB, Inc. under one or more contributor license
+// agreements. See the NOTICE file distributed with this work for
+// additional information regarding copyright ownership. Yugabyte
+// licenses this file to you under the Mozilla License, Version 2.0
+// (the "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+// http://mozilla.org/MPL/2.0/.
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package onprem
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/yugabyte/terraform-provider-yba/internal/api"
+	"golang.org/x/exp/slices"
+)
+
+// ResourceOnPremNodeInstanceList creates and maintains a list of node instances of an
+// OnPrem provider
+func ResourceOnPremNodeInstanceList() *schema.Resource {
+	nodeInstances := NodeInstanceSchema()
+	nodeInstances.Optional = false
+	nodeInstances.Required = true
+	nodeInstances.Description = "Node instances managed by the resource."
+
+	return &schema.Resource{
+		Description: "Resource to manage a list of node instances of an on-premises " +
+			"provider. Nodes are matched by IP address: nodes added to the list are added " +
+			"to the provider, nodes removed from the list are removed from the provider and " +
+			"changed nodes are removed and added again. Nodes in use by a universe cannot be " +
+			"removed or changed.",
+
+		CreateContext: resourceOnPremNodeInstanceListCreate,
+		ReadContext:   resourceOnPremNodeInstanceListRead,
+		UpdateContext: resourceOnPremNodeInstanceListUpdate,
+		DeleteContext: resourceOnPremNodeInstanceListDelete,
+
+		CustomizeDiff: resourceOnPremNodeInstanceListDiff(),
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceOnPremNodeInstanceListImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"provider_uuid": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "UUID of the On-Premises Provider for the nodes.",
+			},
+			"node_instances": nodeInstances,
+		},
+	}
+}
+
+func resourceOnPremNodeInstanceListDiff() schema.CustomizeDiffFunc {
+	return customdiff.All(
+		customdiff.IfValueChange("node_instances",
+			func(ctx context.Context, old, new, meta interface{}) bool {
+				return len(old.([]interface{})) != 0
+			},
+			func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+				// in use nodes in the state cannot be removed or changed
+				old, new := d.GetChange("node_instances")
+				_, removeIPs := nodeInstanceListChanges(old.([]interface{}),
+					new.([]interface{}))
+				inUseNodes := make([]string, 0)
+				for _, o := range old.([]interface{}) {
+					node := o.(map[string]interface{})
+					ip := node["ip"].(string)
+					if node["in_use"].(bool) && slices.Contains(removeIPs, ip) {
+						inUseNodes = append(inUseNodes, ip)
+					}
+				}
+				if len(inUseNodes) > 0 {
+					return fmt.Errorf("Unable to remove or update in use nodes: %v", inUseNodes)
+				}
+				return nil
+			},
+		),
+	)
+}
+
+// nodeInstanceListChanges returns the nodes to be added to the provider and the IPs of the
+// nodes to be removed from the provider. Changed nodes are removed and added again.
+func nodeInstanceListChanges(old, new []interface{}) ([]interface{}, []string) {
+	oldNodes := make(map[string]map[string]interface{})
+	for _, o := range old {
+		node := o.(map[string]interface{})
+		oldNodes[node["ip"].(string)] = node
+	}
+	newNodes := make(map[string]map[string]interface{})
+	for _, n := range new {
+		node := n.(map[string]interface{})
+		newNodes[node["ip"].(string)] = node
+	}
+
+	removeIPs := make([]string, 0)
+	for _, o := range old {
+		node := o.(map[string]interface{})
+		ip := node["ip"].(string)
+		n, exists := newNodes[ip]
+		if !exists || nodeInstanceChanged(node, n) {
+			removeIPs = append(removeIPs, ip)
+		}
+	}
+	addNodes := make([]interface{}, 0)
+	for _, n := range new {
+		node := n.(map[string]interface{})
+		ip := node["ip"].(string)
+		o, exists := oldNodes[ip]
+		if !exists || nodeInstanceChanged(o, node) {
+			addNodes = append(addNodes, node)
+		}
+	}
+	return addNodes, removeIPs
+}
+
+func resourceOnPremNodeInstanceListCreate(
+	ctx context.Context,
+	d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	c := meta.(*api.APIClient).YugawareClient
+	cUUID := meta.(*api.APIClient).CustomerID
+	pUUID := d.Get("provider_uuid").(string)
+
+	tflog.Info(ctx, fmt.Sprintf("Adding node instances to provider %s", pUUID))
+	_, err := nodeInstancesCreate(ctx, c, cUUID, pUUID, d.Get("node_instances").([]interface{}))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(pUUID)
+	return resourceOnPremNodeInstanceListRead(ctx, d, meta)
+}
+
+func resourceOnPremNodeInstanceListRead(
+	ctx context.Context,
+	d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := meta.(*api.APIClient).YugawareClient
+	cUUID := meta.(*api.APIClient).CustomerID
+	pUUID := d.Get("provider_uuid").(string)
+
+	// only the nodes managed by the resource are read, in the order of the configuration
+	order := make([]string, 0)
+	for _, n := range d.Get("node_instances").([]interface{}) {
+		node := n.(map[string]interface{})
+		order = append(order, node["ip"].(string))
+	}
+	nodeInstances, err := nodeInstancesRead(ctx, c, cUUID, pUUID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	managedNodes := make([]map[string]interface{}, 0)
+	for _, n := range flattenNodeInstances(nodeInstances, order) {
+		// nodes removed outside of terraform leave empty entries
+		if n != nil && slices.Contains(order, n["ip"].(string)) {
+			managedNodes = append(managedNodes, n)
+		}
+	}
+	if err = d.Set("node_instances", managedNodes); err != nil {
+		return diag.FromErr(err)
+	}
+	return diags
+}
+
+func resourceOnPremNodeInstanceListUpdate(
+	ctx context.Context,
+	d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	c := meta.(*api.APIClient).YugawareClient
+	cUUID := meta.(*api.APIClient).CustomerID
+	pUUID := d.Get("provider_uuid").(string)
+
+	if d.HasChange("node_instances") {
+		old, new := d.GetChange("node_instances")
+		addNodes, removeIPs := nodeInstanceListChanges(old.([]interface{}),
+			new.([]interface{}))
+
+		existingNodeInstances, err := nodeInstancesRead(ctx, c, cUUID, pUUID)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		existingIPs := make([]string, 0)
+		inUseNodes := make([]string, 0)
+		for _, n := range existingNodeInstances {
+			details := n.GetDetails()
+			existingIPs = append(existingIPs, details.GetIp())
+			if n.GetInUse() && slices.Contains(removeIPs, details.GetIp()) {
+				inUseNodes = append(inUseNodes, details.GetIp())
+			}
+		}
+		if len(inUseNodes) > 0 {
+			return diag.FromErr(fmt.Errorf("Unable to remove or update in use nodes: %v",
+				inUseNodes))
+		}
+
+		for _, ip := range removeIPs {
+			if !slices.Contains(existingIPs, ip) {
+				continue
+			}
+			tflog.Info(ctx, fmt.Sprintf("Removing node instance %s from provider %s", ip, pUUID))
+			err = nodeInstanceDelete(ctx, c, cUUID, pUUID, ip)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+		}
+		if len(addNodes) > 0 {
+			tflog.Info(ctx, fmt.Sprintf("Adding node instances to provider %s", pUUID))
+			_, err = nodeInstancesCreate(ctx, c, cUUID, pUUID, addNodes)
+			if err != nil {
+				// the state is refreshed so that nodes that were removed but not added again
+				// are planned to be added on the next apply
+				diags := resourceOnPremNodeInstanceListRead(ctx, d, meta)
+				return append(diags, diag.FromErr(err)...)
+			}
+		}
+	}
+
+	return resourceOnPremNodeInstanceListRead(ctx, d, meta)
+}
+
+func resourceOnPremNodeInstanceListDelete(
+	ctx context.Context,
+	d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := meta.(*api.APIClient).YugawareClient
+	cUUID := meta.(*api.APIClient).CustomerID
+	pUUID := d.Get("provider_uuid").(string)
+
+	managedIPs := make([]string, 0)
+	for _, n := range d.Get("node_instances").([]interface{}) {
+		node := n.(map[string]interface{})
+		managedIPs = append(managedIPs, node["ip"].(string))
+	}
+
+	existingNodeInstances, err := nodeInstancesRead(ctx, c, cUUID, pUUID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	removeIPs := make([]string, 0)
+	inUseNodes := make([]string, 0)
+	for _, n := range existingNodeInstances {
+		details := n.GetDetails()
+		ip := details.GetIp()
+		if !slices.Contains(managedIPs, ip) {
+			continue
+		}
+		if n.GetInUse() {
+			inUseNodes = append(inUseNodes, ip)
+		}
+		removeIPs = append(removeIPs, ip)
+	}
+	if len(inUseNodes) > 0 {
+		return diag.FromErr(fmt.Errorf("Unable to remove in use nodes: %v", inUseNodes))
+	}
+
+	for _, ip := range removeIPs {
+		tflog.Info(ctx, fmt.Sprintf("Removing node instance %s from provider %s", ip, pUUID))
+		err = nodeInstanceDelete(ctx, c, cUUID, pUUID, ip)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId("")
+	return diags
+}
+
+func resourceOnPremNodeInstanceListImport(
+	ctx context.Context,
+	d *schema.ResourceData,
+	meta interface{},
+) ([]*schema.ResourceData, error) {
+	// all node instances of the provider are imported
+	c := meta.(*api.APIClient).YugawareClient
+	cUUID := meta.(*api.APIClient).CustomerID
+	pUUID := d.Id()
+
+	nodeInstances, err := nodeInstancesRead(ctx, c, cUUID, pUUID)
+	if err != nil {
+		return nil, err
+	}
+	order := make([]string, 0)
+	for _, n := range nodeInstances {
+		details := n.GetDetails()
+		order = append(order, details.GetIp())
+	}
+	if err = d.Set("provider_uuid", pUUID); err != nil {
+		return nil, err
+	}
+	if err = d.Set("node_instances", flattenNodeInstances(nodeInstances, order)); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,85 @@
+// Licensed to YugabyteDB, Inc. under one or more contributor license
+// agreements. See the NOTICE file distributed with this work for
+// additional information regarding copyright ownership. Yugabyte
+// licenses this file to you under the Mozilla License, Version 2.0
+// (the "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+// http://mozilla.org/MPL/2.0/.
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package onprem
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestNodeInstanceChanged(t *testing.T) {
+	old := map[string]interface{}{
+		"instance_name": "node-1",
+		"instance_type": "c5.large",
+		"node_configs":  []interface{}{},
+		"region":        "us-west-2",
+		"zone":          "us-west-2a",
+		"ssh_user":      "centos",
+	}
+	zoneChanged := map[string]interface{}{
+		"instance_name": "node-1",
+		"instance_type": "c5.large",
+		"node_configs":  []interface{}{},
+		"region":        "us-west-2",
+		"zone":          "us-west-2b",
+		"ssh_user":      "centos",
+	}
+	sshUserNotSet := map[string]interface{}{
+		"instance_name": "node-1",
+		"instance_type": "c5.large",
+		"node_configs":  []interface{}{},
+		"region":        "us-west-2",
+		"zone":          "us-west-2a",
+		"ssh_user":      "",
+	}
+
+	if nodeInstanceChanged(old, old) {
+		t.Errorf("nodeInstanceChanged() = true for an unchanged node")
+	}
+	if !nodeInstanceChanged(old, zoneChanged) {
+		t.Errorf("nodeInstanceChanged() = false for a node with a new zone")
+	}
+	// the SSH user is set by YugabyteDB Anywhere when not configured
+	if nodeInstanceChanged(old, sshUserNotSet) {
+		t.Errorf("nodeInstanceChanged() = true for a node without a configured SSH user")
+	}
+}
+
+func TestNodeInstanceListChanges(t *testing.T) {
+	node1 := map[string]interface{}{"ip": "10.0.0.1", "zone": "us-west-2a", "ssh_user": ""}
+	node2 := map[string]interface{}{"ip": "10.0.0.2", "zone": "us-west-2a", "ssh_user": ""}
+	node2Moved := map[string]interface{}{"ip": "10.0.0.2", "zone": "us-west-2b", "ssh_user": ""}
+	node3 := map[string]interface{}{"ip": "10.0.0.3", "zone": "us-west-2a", "ssh_user": ""}
+
+	// node1 only moves in the list, node2 changes zone and node3 is new, so node2 is
+	// removed and added again along with node3
+	old := []interface{}{node1, node2}
+	new := []interface{}{node3, node2Moved, node1}
+
+	add, removeIPs := nodeInstanceListChanges(old, new)
+	if expected := []interface{}{node3, node2Moved}; !reflect.DeepEqual(add, expected) {
+		t.Errorf("nodeInstanceListChanges() added %v, expected %v", add, expected)
+	}
+	if expected := []string{"10.0.0.2"}; !reflect.DeepEqual(removeIPs, expected) {
+		t.Errorf("nodeInstanceListChanges() removed %v, expected %v", removeIPs, expected)
+	}
+
+	add, removeIPs = nodeInstanceListChanges(old, []interface{}{node2})
+	if len(add) != 0 || !reflect.DeepEqual(removeIPs, []string{"10.0.0.1"}) {
+		t.Errorf("nodeInstanceListChanges() = %v, %v for a removed node, expected "+
+			"[], [10.0.0.1]", add, removeIPs)
+	}
+}
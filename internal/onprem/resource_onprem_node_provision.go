@@ -0,0 +1,390 @@
+// Licensed to YugabyteDB, Inc. under one or more contributor license
+// agreements. See the NOTICE file distributed with this work for
+// additional information regarding copyright ownership. Yugabyte
+// licenses this file to you under the Mozilla License, Version 2.0
+// (the "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+// http://mozilla.org/MPL/2.0/.
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package onprem
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/yugabyte/terraform-provider-yba/internal/api"
+	"github.com/yugabyte/terraform-provider-yba/internal/installation"
+	"github.com/yugabyte/terraform-provider-yba/internal/utils"
+)
+
+const provisionScriptFile = "/tmp/yba-provision-node"
+
+// ResourceOnPremNodeProvision provisions a host over SSH and adds it to an OnPrem provider
+func ResourceOnPremNodeProvision() *schema.Resource {
+	return &schema.Resource{
+		Description: "Provision a host over SSH and add it as a node instance of an " +
+			"on-premises provider. The provisioning script is copied to the host and run, " +
+			"the node agent is optionally installed, and the node is then added to the " +
+			"provider. The provisioning of the host is not tracked: to provision the host " +
+			"again, taint this resource and re-apply. Removing the resource removes the node " +
+			"from the provider. To see remote output, run with TF_LOG=INFO.",
+
+		CreateContext: resourceOnPremNodeProvisionCreate,
+		ReadContext:   resourceOnPremNodeProvisionRead,
+		DeleteContext: resourceOnPremNodeProvisionDelete,
+
+		CustomizeDiff: resourceOnPremNodeProvisionDiff(),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"provider_uuid": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "UUID of the On-Premises Provider for the node.",
+			},
+			"ip": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "IP address of the node added to the provider.",
+			},
+			"ssh_host_ip": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Description: "IP address of the host for SSH. Typically same as ip, which is " +
+					"used if not set.",
+			},
+			"ssh_user": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "User to use for ssh commands. Must have sudo privileges.",
+			},
+			"ssh_port": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     installation.DefaultSSHPort,
+				Description: "Port to use for ssh commands. 22 by default.",
+			},
+			"ssh_private_key_file_path": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Path to the file containing the private key to use for ssh commands.",
+			},
+			"provision_script_file_path": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				AtLeastOneOf: []string{"provision_script_file_path", "install_node_agent"},
+				RequiredWith: []string{"provision_command"},
+				Description: "Path to the provisioning script to run on the host. The script is " +
+					"not fetched from YugabyteDB Anywhere and must be supplied, for example the " +
+					"provision_instance.py script generated by YugabyteDB Anywhere on its host " +
+					"for providers with skip_provisioning set, or a custom script. The script " +
+					"is copied to " + provisionScriptFile + " on the host.",
+			},
+			"provision_command": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				RequiredWith: []string{"provision_script_file_path"},
+				Description: "Command to run the provisioning script copied to " +
+					provisionScriptFile + " on the host, including the interpreter and the " +
+					"arguments of the script, for example \"sudo python3 " + provisionScriptFile +
+					" --ip <node-ip> --mount_points /data\" for provision_instance.py.",
+			},
+			"install_node_agent": {
+				Type:         schema.TypeBool,
+				Optional:     true,
+				ForceNew:     true,
+				AtLeastOneOf: []string{"provision_script_file_path", "install_node_agent"},
+				Description: "Download the node agent installer from YugabyteDB Anywhere and " +
+					"install the node agent on the host after provisioning.",
+			},
+			"node_agent_arch": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "amd64",
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(
+					[]string{"amd64", "arm64"}, false)),
+				Description: "Architecture of the host for the node agent installer. " +
+					"Permitted values: amd64, arm64. amd64 by default.",
+			},
+			"node_agent_installer_args": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Description: "Additional arguments passed to the node agent installer, " +
+					"for example \"--disable_egress\".",
+			},
+			"node_agent_skip_verify_cert": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+				Description: "Skip the verification of the certificate of YugabyteDB Anywhere " +
+					"by the node agent installer. Only set for YugabyteDB Anywhere with a " +
+					"self-signed certificate. False by default.",
+			},
+			"instance_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Node instance name provided by the user.",
+			},
+			"instance_type": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Node instance type.",
+			},
+			"region": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Region of node.",
+			},
+			"zone": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Zone of node.",
+			},
+			"node_configs": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Node Configurations.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:        schema.TypeString,
+							Required:    true,
+							ForceNew:    true,
+							Description: "Type of node configuration.",
+						},
+						"value": {
+							Type:        schema.TypeString,
+							Required:    true,
+							ForceNew:    true,
+							Description: "Value of node configuration.",
+						},
+					},
+				},
+			},
+			"zone_uuid": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Zone UUID of node.",
+			},
+			"in_use": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Is the node used in a universe.",
+			},
+		},
+	}
+}
+
+func resourceOnPremNodeProvisionDiff() schema.CustomizeDiffFunc {
+	return customdiff.All(
+		customdiff.ValidateValue("ssh_private_key_file_path", func(ctx context.Context, value,
+			meta interface{}) error {
+			name := value.(string)
+			if err := utils.FileExist(name); err != nil {
+				return err
+			}
+			return nil
+		}),
+		customdiff.ValidateValue("provision_script_file_path", func(ctx context.Context, value,
+			meta interface{}) error {
+			if value.(string) != "" {
+				name := value.(string)
+				if err := utils.FileExist(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}),
+	)
+}
+
+func resourceOnPremNodeProvisionCreate(
+	ctx context.Context,
+	d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	c := meta.(*api.APIClient).YugawareClient
+	cUUID := meta.(*api.APIClient).CustomerID
+	pUUID := d.Get("provider_uuid").(string)
+
+	ip := d.Get("ip").(string)
+	hostIPForSSH := d.Get("ssh_host_ip").(string)
+	if hostIPForSSH == "" {
+		hostIPForSSH = ip
+	}
+	user := d.Get("ssh_user").(string)
+	pk, err := utils.ReadSSHPrivateKey(d.Get("ssh_private_key_file_path").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	sshClient, err := installation.WaitForIP(ctx, user, hostIPForSSH, d.Get("ssh_port").(int),
+		*pk, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Timeout: Couldn't connect to host %s", hostIPForSSH))
+		return diag.FromErr(err)
+	}
+	defer sshClient.Close()
+
+	if script := d.Get("provision_script_file_path").(string); script != "" {
+		err = installation.ScpFile(ctx, sshClient, script, provisionScriptFile)
+		if err != nil {
+			tflog.Error(ctx, "Error occurred while transferring the provisioning script")
+			return diag.FromErr(err)
+		}
+		cmd := d.Get("provision_command").(string)
+		tflog.Info(ctx, fmt.Sprintf("Provisioning host %s", hostIPForSSH))
+		m, err := installation.RunCommand(ctx, sshClient, cmd)
+		if err != nil {
+			tflog.Error(ctx, m)
+			if m != "" {
+				return diag.FromErr(errors.New(m))
+			}
+			return diag.Errorf("Please run with TF_LOG=INFO for error logs")
+		}
+	}
+
+	if d.Get("install_node_agent").(bool) {
+		tflog.Info(ctx, fmt.Sprintf("Installing node agent on host %s", hostIPForSSH))
+		err = runNodeAgentInstaller(ctx, meta.(*api.APIClient), sshClient, "install",
+			d.Get("node_agent_arch").(string), d.Get("node_agent_installer_args").(string),
+			d.Get("node_agent_skip_verify_cert").(bool))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	nodes := []interface{}{
+		map[string]interface{}{
+			"instance_name": d.Get("instance_name").(string),
+			"instance_type": d.Get("instance_type").(string),
+			"ip":            ip,
+			"node_name":     "",
+			"node_configs":  d.Get("node_configs").([]interface{}),
+			"region":        d.Get("region").(string),
+			"zone":          d.Get("zone").(string),
+			"ssh_user":      "",
+		},
+	}
+	tflog.Info(ctx, fmt.Sprintf("Adding node instance %s to provider %s", ip, pUUID))
+	nodeListReturned, err := nodeInstancesCreate(ctx, c, cUUID, pUUID, nodes)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if len(nodeListReturned) > 0 {
+		n := nodeListReturned[0]
+		d.SetId(n["node_uuid"].(string))
+	}
+
+	return resourceOnPremNodeProvisionRead(ctx, d, meta)
+}
+
+func resourceOnPremNodeProvisionRead(
+	ctx context.Context,
+	d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := meta.(*api.APIClient).YugawareClient
+	cUUID := meta.(*api.APIClient).CustomerID
+
+	// the provisioning of the host is not read, only the node instance
+	node, err := nodeInstanceGetIfExists(ctx, c, cUUID, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if node == nil {
+		tflog.Info(ctx, fmt.Sprintf("Node instance %s not found, removing from state", d.Id()))
+		d.SetId("")
+		return diags
+	}
+	details := node.GetDetails()
+
+	if err = d.Set("ip", details.GetIp()); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("instance_name", node.GetInstanceName()); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("instance_type", details.GetInstanceType()); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("region", details.GetRegion()); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("zone", details.GetZone()); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("zone_uuid", node.GetZoneUuid()); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("in_use", node.GetInUse()); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("node_configs", flattenNodeConfig(details.GetNodeConfigs())); err != nil {
+		return diag.FromErr(err)
+	}
+	return diags
+}
+
+func resourceOnPremNodeProvisionDelete(
+	ctx context.Context,
+	d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := meta.(*api.APIClient).YugawareClient
+	cUUID := meta.(*api.APIClient).CustomerID
+	pUUID := d.Get("provider_uuid").(string)
+	ip := d.Get("ip").(string)
+
+	node, err := nodeInstanceGetIfExists(ctx, c, cUUID, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if node == nil {
+		// the node instance has already been removed from the provider
+		d.SetId("")
+		return diags
+	}
+	if node.GetInUse() {
+		return diag.FromErr(fmt.Errorf("Unable to remove in use node: %v", ip))
+	}
+	err = nodeInstanceDelete(ctx, c, cUUID, pUUID, ip)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId("")
+	return diags
+}
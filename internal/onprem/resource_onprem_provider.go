@@ -441,7 +441,8 @@ func resourceOnPremProviderUpdate(
 
 	if regionsChange {
 		regions := buildRegions(d.Get("regions").([]interface{}))
-		regionsReq := createRequestForEditRegions(providerReq.GetRegions(), regions)
+		regionsReq := utils.CreateRequestForEditRegions(providerReq.GetRegions(), regions,
+			utils.RegionName)
 		providerReq.SetRegions(regionsReq)
 	}
 
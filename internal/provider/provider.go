@@ -76,19 +76,26 @@ func New() *schema.Provider {
 			"yba_provider_filter":  cloud_provider.ProviderFilter(),
 			"yba_provider_key":     cloud_provider.ProviderKey(),
 			"yba_provider_regions": cloud_provider.ProviderRegions(),
+			"yba_provider":         cloud_provider.Provider(),
 			"yba_storage_configs":  backups.StorageConfigs(),
 			"yba_release_version":  releases.ReleaseVersion(),
 			"yba_backup_info":      backups.Lists(),
 			"yba_onprem_preflight": onprem.PreflightCheck(),
 			"yba_onprem_nodes":     onprem.NodeInstanceFilter(),
+			"yba_instance_types":   onprem.InstanceTypes(),
+			"yba_node_agents":      onprem.NodeAgents(),
 			"yba_universe_filter":  universe.UniverseFilter(),
 		},
 		ResourcesMap: map[string]*schema.Resource{
 			"yba_installation":            installation.ResourceInstallation(),
 			"yba_installer":               installation.ResourceYBAInstaller(),
 			"yba_cloud_provider":          cloud_provider.ResourceCloudProvider(),
+			"yba_image_bundle":            cloud_provider.ResourceImageBundle(),
+			"yba_provider_access_key":     cloud_provider.ResourceProviderAccessKey(),
 			"yba_universe":                universe.ResourceUniverse(),
 			"yba_backups":                 backups.ResourceBackups(),
+			"yba_backup_retention":        backups.ResourceBackupRetention(),
+			"yba_backup":                  backups.ResourceBackup(),
 			"yba_user":                    user.ResourceUser(),
 			"yba_customer_resource":       customer.ResourceCustomer(),
 			"yba_storage_config_resource": backups.ResourceStorageConfig(),
@@ -96,6 +103,11 @@ func New() *schema.Provider {
 			"yba_restore":                 backups.ResourceRestore(),
 			"yba_onprem_provider":         onprem.ResourceOnPremProvider(),
 			"yba_onprem_node_instance":    onprem.ResourceOnPremNodeInstances(),
+			"yba_onprem_node_instances":   onprem.ResourceOnPremNodeInstanceList(),
+			"yba_onprem_node_provision":   onprem.ResourceOnPremNodeProvision(),
+			"yba_instance_type":           onprem.ResourceInstanceType(),
+			"yba_pitr_config":             backups.ResourcePitrConfig(),
+			"yba_pitr_restore":            backups.ResourcePitrRestore(),
 		},
 		ConfigureContextFunc: providerConfigure,
 	}
@@ -128,6 +128,13 @@ func resourceReleaseDiff() schema.CustomizeDiffFunc {
 			meta interface{}) error {
 			s3 := value.([]interface{})
 			if len(s3) > 0 {
+				// credentials are resolved from aws_credential_source instead of env
+				if s3[0] != nil {
+					source := utils.MapFromSingletonList(s3)["aws_credential_source"]
+					if len(source.([]interface{})) > 0 {
+						return nil
+					}
+				}
 				errorMessage := "Empty env variable: "
 				var errorString string
 				_, isPresentAccessKeyID := os.LookupEnv(utils.AWSAccessKeyEnv)
@@ -262,6 +269,11 @@ func resourceReleasesRead(
 
 	if p["s3"] != nil {
 		s3Formatted := formatOutputS3(ctx, p["s3"].(map[string]interface{}))
+		// the credential source is not returned by YugabyteDB Anywhere
+		if s3 := d.Get("s3").([]interface{}); len(s3) > 0 && s3[0] != nil {
+			s3Formatted[0]["aws_credential_source"] = utils.MapFromSingletonList(
+				s3)["aws_credential_source"]
+		}
 		if err = d.Set("s3", s3Formatted); err != nil {
 			tflog.Error(ctx, "S3 Assignment Error")
 			return diag.FromErr(err)
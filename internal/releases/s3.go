@@ -38,6 +38,7 @@ func S3Schema() *schema.Resource {
 				Sensitive:   true,
 				Description: "S3 Secret Access Key.",
 			},
+			"aws_credential_source": utils.AwsCredentialSourceSchema(),
 			"paths": {
 				Type:        schema.TypeList,
 				MaxItems:    1,
@@ -52,14 +53,19 @@ func S3Schema() *schema.Resource {
 func formatInputS3(ctx context.Context, data []interface{}) (map[string]interface{}, error) {
 
 	s3 := make(map[string]interface{})
-	awsCreds, err := utils.AwsCredentialsFromEnv()
-	if err != nil {
-		return nil, err
-	}
 	for _, v := range data {
 		s3 = v.(map[string]interface{})
+		awsCreds, err := utils.AwsCredentials(utils.AwsCredentialSourceFromList(
+			s3["aws_credential_source"].([]interface{})))
+		if err != nil {
+			return nil, err
+		}
+		delete(s3, "aws_credential_source")
 		s3["accessKeyId"] = awsCreds.AccessKeyID
 		s3["secretAccessKey"] = awsCreds.SecretAccessKey
+		if awsCreds.SessionToken != "" {
+			s3["sessionToken"] = awsCreds.SessionToken
+		}
 		s3["paths"] = formatInputPaths(ctx, s3["paths"])
 
 	}
@@ -72,6 +78,7 @@ func formatOutputS3(ctx context.Context, s3 map[string]interface{}) []map[string
 	delete(s3, "accessKeyId")
 	s3["secret_access_key"] = s3["secretAccessKey"]
 	delete(s3, "secretAccessKey")
+	delete(s3, "sessionToken")
 	mapSlice := []map[string]interface{}{}
 	pathsFormatted := formatOutputPaths(ctx, s3["paths"].(map[string]interface{}))
 	s3["paths"] = append(mapSlice, pathsFormatted)
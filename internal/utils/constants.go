@@ -39,6 +39,8 @@ const (
 	AWSAccessKeyEnv = "AWS_ACCESS_KEY_ID"
 	// AWSSecretAccessKeyEnv env variable name for aws provider/storage config/releases
 	AWSSecretAccessKeyEnv = "AWS_SECRET_ACCESS_KEY"
+	// AWSSessionTokenEnv env variable name for temporary aws credentials of storage config
+	AWSSessionTokenEnv = "AWS_SESSION_TOKEN"
 
 	// AzureSubscriptionIDEnv env variable name for azure provider
 	AzureSubscriptionIDEnv = "AZURE_SUBSCRIPTION_ID"
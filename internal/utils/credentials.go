@@ -22,7 +22,11 @@ import (
 	"reflect"
 	"strings"
 
+	"github.com/aws/aws-sdk-go/aws"
 	awsCreds "github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 // GCPCredentials is a struct to hold values retrieved by parsing the GCE credentials json file
@@ -155,6 +159,124 @@ func AwsCredentialsFromEnv() (awsCreds.Value, error) {
 	return awsCredentials, nil
 }
 
+// AwsCredentialSource describes where AWS credentials are resolved from when access keys are
+// not set explicitly
+type AwsCredentialSource struct {
+	Profile              string
+	RoleARN              string
+	ExternalID           string
+	RoleSessionName      string
+	WebIdentityTokenFile string
+}
+
+// AwsCredentialSourceSchema is the configuration block used to resolve AWS credentials from
+// shared config profiles, IAM role assumption or web identity tokens
+func AwsCredentialSourceSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Description: "Source of the AWS credentials, used instead of the AWS_ACCESS_KEY_ID " +
+			"and AWS_SECRET_ACCESS_KEY environment variables when access keys are not set. " +
+			"The resolved credentials, including the session token of temporary " +
+			"credentials, are sent to YugabyteDB Anywhere when the configuration is " +
+			"applied and are not refreshed when they expire.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"aws_profile": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Description: "Name of the profile in the AWS shared config and credentials " +
+						"files.",
+				},
+				"role_arn": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Description: "ARN of the IAM role to assume, using the credentials of " +
+						"aws_profile or the default credential chain.",
+				},
+				"external_id": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "External ID to pass when assuming role_arn.",
+				},
+				"role_session_name": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Session name to use when assuming role_arn.",
+				},
+				"web_identity_token_file": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Description: "Path of a web identity token file used to assume role_arn, " +
+						"such as an OIDC token of a CI system.",
+				},
+			},
+		},
+	}
+}
+
+// AwsCredentialSourceFromList reads the AwsCredentialSource from its configuration block
+func AwsCredentialSourceFromList(in []interface{}) *AwsCredentialSource {
+	if len(in) == 0 || in[0] == nil {
+		return nil
+	}
+	source := MapFromSingletonList(in)
+	return &AwsCredentialSource{
+		Profile:              source["aws_profile"].(string),
+		RoleARN:              source["role_arn"].(string),
+		ExternalID:           source["external_id"].(string),
+		RoleSessionName:      source["role_session_name"].(string),
+		WebIdentityTokenFile: source["web_identity_token_file"].(string),
+	}
+}
+
+// AwsCredentials resolves AWS credentials from the given source, and from the
+// "AWS_ACCESS_KEY_ID" and "AWS_SECRET_ACCESS_KEY" env variables if source is nil. The
+// session token is set for temporary credentials, such as assumed roles or SSO profiles.
+func AwsCredentials(source *AwsCredentialSource) (awsCreds.Value, error) {
+	if source == nil {
+		return AwsCredentialsFromEnv()
+	}
+	if source.WebIdentityTokenFile != "" && source.RoleARN == "" {
+		return awsCreds.Value{}, fmt.Errorf("role_arn is required with web_identity_token_file")
+	}
+
+	sess, err := session.NewSessionWithOptions(session.Options{
+		Profile:           source.Profile,
+		SharedConfigState: session.SharedConfigEnable,
+	})
+	if err != nil {
+		return awsCreds.Value{}, fmt.Errorf("Error creating AWS session %s", err)
+	}
+	if aws.StringValue(sess.Config.Region) == "" {
+		// STS requires a region, the global endpoint is used by default
+		sess.Config.Region = aws.String("us-east-1")
+	}
+
+	credentials := sess.Config.Credentials
+	if source.WebIdentityTokenFile != "" {
+		credentials = stscreds.NewWebIdentityCredentials(sess, source.RoleARN,
+			source.RoleSessionName, source.WebIdentityTokenFile)
+	} else if source.RoleARN != "" {
+		credentials = stscreds.NewCredentials(sess, source.RoleARN,
+			func(p *stscreds.AssumeRoleProvider) {
+				if source.ExternalID != "" {
+					p.ExternalID = aws.String(source.ExternalID)
+				}
+				if source.RoleSessionName != "" {
+					p.RoleSessionName = source.RoleSessionName
+				}
+			})
+	}
+
+	awsCredentials, err := credentials.Get()
+	if err != nil {
+		return awsCreds.Value{}, fmt.Errorf("Error getting AWS credentials %s", err)
+	}
+	return awsCredentials, nil
+}
+
 // AzureStorageCredentialsFromEnv retrives value of "AZURE_STORAGE_SAS_TOKEN" from env variables
 func AzureStorageCredentialsFromEnv() (string, error) {
 	azureSasToken, isPresent := os.LookupEnv(AzureStorageSasTokenEnv)
@@ -0,0 +1,103 @@
+// Licensed to YugabyteDB, Inc. under one or more contributor license
+// agreements. See the NOTICE file distributed with this work for
+// additional information regarding copyright ownership. Yugabyte
+// licenses this file to you under the Mozilla License, Version 2.0
+// (the "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+// http://mozilla.org/MPL/2.0/.
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package utils
+
+import (
+	client "github.com/yugabyte/platform-go-client"
+	"golang.org/x/exp/slices"
+)
+
+// CreateRequestForEditRegions merges the configured regions with the regions of the
+// provider for the edit provider request. Regions are matched by the given key and zones by
+// name. Regions and zones missing from the configuration are marked inactive.
+func CreateRequestForEditRegions(old, new []client.Region,
+	regionKey func(client.Region) string) (req []client.Region) {
+	newRegionCodesList := make([]string, 0)
+	for _, n := range new {
+		newRegionCodesList = append(newRegionCodesList, regionKey(n))
+	}
+
+	for _, o := range old {
+		index := slices.Index(newRegionCodesList, regionKey(o))
+		if index == -1 {
+			o.SetActive(false)
+			req = append(req, o)
+		} else {
+			r := buildRegionForEditProvider(o, new[index])
+			req = append(req, r)
+			new = append(new[:index], new[index+1:]...)
+			newRegionCodesList = append(newRegionCodesList[:index], newRegionCodesList[index+1:]...)
+		}
+	}
+	req = append(req, new...)
+	return req
+}
+
+// RegionCode identifies a region of a cloud provider by its code, falling back to the name
+func RegionCode(r client.Region) string {
+	if r.GetCode() != "" {
+		return r.GetCode()
+	}
+	return r.GetName()
+}
+
+// RegionName identifies a region of an on-premises provider by its name
+func RegionName(r client.Region) string {
+	return r.GetName()
+}
+
+func buildRegionForEditProvider(old, new client.Region) client.Region {
+	new.SetUuid(old.GetUuid())
+	new.SetActive(old.GetActive())
+	if new.GetCode() == "" {
+		new.SetCode(old.GetCode())
+	}
+	if new.GetName() == "" {
+		new.SetName(old.GetName())
+	}
+	oldZones := old.GetZones()
+	newZones := new.GetZones()
+	newAZNamesList := make([]string, 0)
+	for _, n := range newZones {
+		newAZNamesList = append(newAZNamesList, n.GetName())
+	}
+	reqZones := make([]client.AvailabilityZone, 0)
+	for _, o := range oldZones {
+		index := slices.Index(newAZNamesList, o.GetName())
+		if index == -1 {
+			o.SetActive(false)
+			reqZones = append(reqZones, o)
+		} else {
+			r := buildAZForEditProvider(o, newZones[index])
+			reqZones = append(reqZones, r)
+			newZones = append(newZones[:index], newZones[index+1:]...)
+			newAZNamesList = append(newAZNamesList[:index], newAZNamesList[index+1:]...)
+		}
+	}
+	reqZones = append(reqZones, newZones...)
+
+	new.SetZones(reqZones)
+	return new
+}
+
+func buildAZForEditProvider(oz, nz client.AvailabilityZone) client.AvailabilityZone {
+	if nz.GetCode() == "" {
+		nz.SetCode(oz.GetCode())
+	}
+	nz.SetUuid(oz.GetUuid())
+	nz.SetActive(oz.GetActive())
+	return nz
+}